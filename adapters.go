@@ -0,0 +1,152 @@
+package xl8r
+
+import (
+	"fmt"
+	"io"
+)
+
+var _ Codec[[]byte, int] = (*contentAdapter[[]byte, string, int])(nil) //contract
+var _ Codec[string, []int] = (*hubAdapter[string, int, []int])(nil)    //contract
+var _ Initializer = (*lifecycleForwarder)(nil)                         //contract
+var _ io.Closer = (*lifecycleForwarder)(nil)                           //contract
+var _ HealthChecker = (*lifecycleForwarder)(nil)                       //contract
+
+// a function that converts a value of one type into another
+type Conversion[A, B any] func(v A) (r B, e error)
+
+// a Conversion between string-like and byte-slice-like types
+//   - eg. AdaptContent[[]byte](c, ConvertText[[]byte, string], ConvertText[string, []byte])
+func ConvertText[A, B ~string | ~[]byte](v A) (r B, e error) {
+	r = B(v)
+	return
+}
+
+// wraps a codec over content of type P, as a codec over content of type Q
+//   - toInner converts content of type Q, before it is encoded (or evaluated) by the codec
+//   - fromInner converts content of type P, after it is decoded by the codec
+//   - content that toInner cannot convert is not processable (ie. Evaluate returns bool false)
+//   - the name, and the Init, Close and Health methods (if any) of the codec are kept
+func AdaptContent[Q, P, H any](c Codec[P, H], toInner Conversion[Q, P], fromInner Conversion[P, Q]) (r Codec[Q, H]) {
+	r = &contentAdapter[Q, P, H]{
+		lifecycleForwarder: lifecycleForwarder{inner: c},
+		codec:              c,
+		toInner:            toInner,
+		fromInner:          fromInner,
+	}
+	return
+}
+
+// wraps a codec with hub data of type H, as a codec with hub data of type G
+//   - fromInner converts hub data of type H, after it is encoded by the codec
+//   - toInner converts hub data of type G, before it is decoded by the codec
+//   - the name, the Evaluate semantics, and the Init, Close and Health methods (if any) of the codec are kept
+func AdaptHub[G, P, H any](c Codec[P, H], toInner Conversion[G, H], fromInner Conversion[H, G]) (r Codec[P, G]) {
+	r = &hubAdapter[P, H, G]{
+		lifecycleForwarder: lifecycleForwarder{inner: c},
+		codec:              c,
+		toInner:            toInner,
+		fromInner:          fromInner,
+	}
+	return
+}
+
+type contentAdapter[Q, P, H any] struct {
+	lifecycleForwarder
+	codec     Codec[P, H]
+	toInner   Conversion[Q, P]
+	fromInner Conversion[P, Q]
+}
+
+func (a *contentAdapter[Q, P, H]) Name() string {
+	return a.codec.Name()
+}
+
+func (a *contentAdapter[Q, P, H]) Encode(v Q, opts0 ...Opts) (r H, e error) {
+	var p P
+	if p, e = a.toInner(v); e != nil {
+		e = fmt.Errorf("content conversion failed [ '%s' ]: %w", a.Name(), e)
+		return
+	}
+	r, e = a.codec.Encode(p, opts0...)
+	return
+}
+
+func (a *contentAdapter[Q, P, H]) Decode(v H, opts0 ...Opts) (r Q, e error) {
+	var p P
+	if p, e = a.codec.Decode(v, opts0...); e != nil {
+		return
+	}
+	if r, e = a.fromInner(p); e != nil {
+		e = fmt.Errorf("content conversion failed [ '%s' ]: %w", a.Name(), e)
+	}
+	return
+}
+
+func (a *contentAdapter[Q, P, H]) Evaluate(v Q) (r bool) {
+	if p, err := a.toInner(v); err == nil {
+		r = a.codec.Evaluate(p)
+	}
+	return
+}
+
+type hubAdapter[P, H, G any] struct {
+	lifecycleForwarder
+	codec     Codec[P, H]
+	toInner   Conversion[G, H]
+	fromInner Conversion[H, G]
+}
+
+func (a *hubAdapter[P, H, G]) Name() string {
+	return a.codec.Name()
+}
+
+func (a *hubAdapter[P, H, G]) Encode(v P, opts0 ...Opts) (r G, e error) {
+	var h H
+	if h, e = a.codec.Encode(v, opts0...); e != nil {
+		return
+	}
+	if r, e = a.fromInner(h); e != nil {
+		e = fmt.Errorf("hub data conversion failed [ '%s' ]: %w", a.Name(), e)
+	}
+	return
+}
+
+func (a *hubAdapter[P, H, G]) Decode(v G, opts0 ...Opts) (r P, e error) {
+	var h H
+	if h, e = a.toInner(v); e != nil {
+		e = fmt.Errorf("hub data conversion failed [ '%s' ]: %w", a.Name(), e)
+		return
+	}
+	r, e = a.codec.Decode(h, opts0...)
+	return
+}
+
+func (a *hubAdapter[P, H, G]) Evaluate(v P) bool {
+	return a.codec.Evaluate(v)
+}
+
+// forwards the optional lifecycle methods, to the wrapped codec
+type lifecycleForwarder struct {
+	inner any
+}
+
+func (f *lifecycleForwarder) Init() (e error) {
+	if initializer, ok := f.inner.(Initializer); ok {
+		e = initializer.Init()
+	}
+	return
+}
+
+func (f *lifecycleForwarder) Close() (e error) {
+	if closer, ok := f.inner.(io.Closer); ok {
+		e = closer.Close()
+	}
+	return
+}
+
+func (f *lifecycleForwarder) Health() (e error) {
+	if checker, ok := f.inner.(HealthChecker); ok {
+		e = checker.Health()
+	}
+	return
+}
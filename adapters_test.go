@@ -0,0 +1,97 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"unicode/utf8"
+)
+
+func testNumberTables(t *testing.T) (english, spanish *Spoke[string, int]) {
+	t.Helper()
+	var err error
+	english, err = NewTableCodec[string, int]("english").Add(1, "one").Add(2, "two").Build()
+	assrtNil(t, err)
+	spanish, err = NewTableCodec[string, int]("spanish").Add(1, "uno").Add(2, "dos").Build()
+	assrtNil(t, err)
+	return
+}
+
+func TestAdaptContent(t *testing.T) {
+	english, spanish := testNumberTables(t)
+	fromBytes := func(v []byte) (r string, e error) {
+		if !utf8.Valid(v) {
+			e = fmt.Errorf("invalid UTF-8")
+		}
+		r = string(v)
+		return
+	}
+
+	translateBytes, err := New(
+		AdaptContent(english, fromBytes, ConvertText[string, []byte]),
+		AdaptContent(spanish, fromBytes, ConvertText[string, []byte]),
+	)
+	assrtNil(t, err)
+	assrtTrue(t, translateBytes.Knows("english"))
+
+	result, tErr := translateBytes.To("spanish", "english", []byte("Two"))
+	assrtNil(t, tErr)
+	assrtEqual(t, []byte("dos"), result)
+	assrtEqual(t, []string{"spanish"}, translateBytes.Origins([]byte("uno")))
+
+	// content that cannot be converted is not processable
+	assrtEqual(t, 0, len(translateBytes.Origins([]byte{0xff})))
+	_, tErr = translateBytes.To("spanish", "english", []byte{0xff})
+	assrtNotNil(t, tErr)
+	t.Log(tErr)
+
+	// the same codecs, within an Interpreter over a custom string type
+	translateLang, err := New(
+		AdaptContent(english, ConvertText[myLanguageContentType, string], ConvertText[string, myLanguageContentType]),
+		AdaptContent(spanish, ConvertText[myLanguageContentType, string], ConvertText[string, myLanguageContentType]),
+	)
+	assrtNil(t, err)
+	langResult, tErr := translateLang.To("english", "spanish", "uno")
+	assrtNil(t, tErr)
+	assrtEqual(t, myLanguageContentType("one"), langResult)
+}
+
+func TestAdaptHub(t *testing.T) {
+	english, spanish := testNumberTables(t)
+	toText := func(v int) (string, error) { return strconv.Itoa(v), nil }
+
+	translate, err := New(
+		AdaptHub(english, strconv.Atoi, toText),
+		AdaptHub(spanish, strconv.Atoi, toText),
+	)
+	assrtNil(t, err)
+
+	result, tErr := translate.To("spanish", "english", "one")
+	assrtNil(t, tErr)
+	assrtEqual(t, "uno", result)
+	hub, tErr := translate.Encode("english", "two")
+	assrtNil(t, tErr)
+	assrtEqual(t, "2", hub)
+	assrtEqual(t, []string{"english"}, translate.Origins("one"))
+
+	_, tErr = translate.Decode("spanish", "II")
+	assrtTrue(t, errors.Is(tErr, strconv.ErrSyntax))
+	t.Log(tErr)
+}
+
+func TestAdaptLifecycle(t *testing.T) {
+	var events []string
+	inner := newTestLifecycleSpoke("tagging", &events)
+	inner.healthErr = fmt.Errorf("degraded")
+
+	adapted := AdaptContent(inner, ConvertText[[]byte, string], ConvertText[string, []byte])
+	other := AdaptContent[[]byte](testTaggingSpoke("other"), ConvertText[[]byte, string], ConvertText[string, []byte])
+	translate, err := New(adapted, other)
+	assrtNil(t, err)
+	assrtEqual(t, []string{"init tagging"}, events)
+	assrtEqual(t, inner.healthErr, translate.Health()["tagging"])
+	assrtNil(t, translate.Health()["other"])
+	assrtNil(t, translate.Close())
+	assrtEqual(t, []string{"init tagging", "close tagging"}, events)
+}
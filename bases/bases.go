@@ -0,0 +1,327 @@
+/*
+Package bases provides codecs for integers written in number bases (eg. binary, decimal, hexadecimal, base58),
+with *big.Int hub data, so that numbers of any size can be converted.
+
+	convertBase, err := bases.New(xl8r.Config{})
+	hex, err := convertBase.To("hex", "decimal", "-255")	// "-ff"
+
+The codecs are also registered under the "bases" domain (see xl8r.NewFromDomain).
+*/
+package bases
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "bases"
+
+const (
+	// the digits of bases 2 through 36 (case-insensitive)
+	DigitsBase36 = "0123456789abcdefghijklmnopqrstuvwxyz"
+	// the digits of base58, as used by Bitcoin addresses
+	DigitsBase58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	// the digits of Crockford's base32
+	DigitsCrockford32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// the digits of base62
+	DigitsBase62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// the largest number a base1 (ie. unary) codec decodes
+const MaxUnary = 1 << 20
+
+// the digits used by big.Int, for bases up to 62
+const bigDigits = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// a builder of codecs, for integers written in a number base
+//   - numbers may be negative, with a leading "-" (or "+" for positive numbers)
+//   - the sign is followed by the (optional) Prefix, and the digits
+type Base struct {
+	// name of the codec
+	Id string
+	// the number base, from 2 to 36, using DigitsBase36 (case-insensitive)
+	//   - ignored, if Alphabet is set
+	Radix int
+	// optional digits of the number base (eg. DigitsBase58)
+	//   - the number base is the number of digits, and digits are case-sensitive,
+	//     unless CaseInsensitive is set
+	Alphabet string
+	// when bool true, digits of the Alphabet are matched case-insensitively
+	CaseInsensitive bool
+	// optional runes, accepted when encoding in place of digits (eg. 'O' for '0')
+	Equivalents map[rune]rune
+	// optional prefix (eg. "0x"), accepted (but not required) when encoding
+	Prefix string
+	// when bool true, decoding produces the Prefix
+	EmitPrefix bool
+	// runes that are ignored when encoding (eg. "_" for 1_000_000)
+	Separators string
+	// when greater than 0, decoding separates groups of this many digits (counted from the right)
+	GroupSize int
+	// the separator of digit groups (eg. ","), also ignored when encoding
+	GroupSep string
+}
+
+// builds the codec
+//   - returns an error for an invalid name, base or alphabet
+func (b *Base) Build() (r *xl8r.Spoke[string, *big.Int], e error) {
+	var errs []error
+	if len(b.Id) == 0 {
+		errs = append(errs, fmt.Errorf("empty codec name"))
+	}
+	digits := []rune(b.Alphabet)
+	fold := b.CaseInsensitive
+	if len(digits) == 0 {
+		if b.Radix < 2 || b.Radix > len(DigitsBase36) {
+			errs = append(errs, fmt.Errorf("invalid radix %d", b.Radix))
+		} else {
+			digits, fold = []rune(DigitsBase36[:b.Radix]), true
+		}
+	}
+	values := make(map[rune]int, len(digits))
+	for i, d := range digits {
+		if fold {
+			d = unicode.ToLower(d)
+		}
+		if _, dup := values[d]; dup {
+			errs = append(errs, fmt.Errorf("duplicate digit '%c'", d))
+		}
+		values[d] = i
+	}
+	if len(b.Alphabet) > 0 && len(digits) < 2 {
+		errs = append(errs, fmt.Errorf("an alphabet of at least two digits is required"))
+	}
+	for from, to := range b.Equivalents {
+		if fold {
+			from, to = unicode.ToLower(from), unicode.ToLower(to)
+		}
+		if _, exists := values[to]; !exists {
+			errs = append(errs, fmt.Errorf("equivalent of '%c' is not a digit", from))
+		} else if _, exists = values[from]; exists {
+			errs = append(errs, fmt.Errorf("equivalent '%c' is a digit", from))
+		} else {
+			values[from] = values[to]
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		e = fmt.Errorf("base codec [ '%s' ]: %w", b.Id, err)
+		return
+	}
+
+	n := &numeral{
+		Base:   *b,
+		digits: digits,
+		values: values,
+		fold:   fold,
+	}
+	r = &xl8r.Spoke[string, *big.Int]{
+		Id:  b.Id,
+		Enc: n.encode,
+		Dec: n.decode,
+		Check: func(v string) (r bool) {
+			_, err := n.encode(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+type numeral struct {
+	Base
+	digits []rune
+	values map[rune]int
+	fold   bool
+}
+
+func (n *numeral) encode(v string, _ ...xl8r.Opts) (r *big.Int, e error) {
+	s := strings.TrimSpace(v)
+	negative := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		negative, s = s[0] == '-', s[1:]
+	}
+	if len(n.Prefix) > 0 && len(s) > len(n.Prefix) && strings.EqualFold(s[:len(n.Prefix)], n.Prefix) {
+		s = s[len(n.Prefix):]
+	}
+
+	// translates the digits to those used by big.Int, when possible
+	radix := len(n.digits)
+	std := &strings.Builder{}
+	r = new(big.Int)
+	count := 0
+	for _, c := range s {
+		if strings.ContainsRune(n.Separators, c) || strings.ContainsRune(n.GroupSep, c) {
+			continue
+		}
+		if n.fold {
+			c = unicode.ToLower(c)
+		}
+		value, isDigit := n.values[c]
+		if !isDigit {
+			e = fmt.Errorf("invalid base %d number [ %s ]: unexpected '%c'", radix, v, c)
+			return
+		}
+		count++
+		if radix <= len(bigDigits) {
+			std.WriteByte(bigDigits[value])
+		} else {
+			r.Mul(r, big.NewInt(int64(radix)))
+			r.Add(r, big.NewInt(int64(value)))
+		}
+	}
+	if count == 0 {
+		e = fmt.Errorf("invalid base %d number [ %s ]: no digits", radix, v)
+		return
+	}
+	if radix <= len(bigDigits) {
+		r.SetString(std.String(), radix)
+	}
+	if negative {
+		r.Neg(r)
+	}
+	return
+}
+
+func (n *numeral) decode(v *big.Int, _ ...xl8r.Opts) (r string, e error) {
+	if v == nil {
+		e = fmt.Errorf("nil number")
+		return
+	}
+	radix := len(n.digits)
+	var digits []rune
+	abs := new(big.Int).Abs(v)
+	if radix <= len(bigDigits) {
+		for _, c := range abs.Text(radix) {
+			digits = append(digits, n.digits[strings.IndexRune(bigDigits, c)])
+		}
+	} else {
+		base, mod := big.NewInt(int64(radix)), new(big.Int)
+		for abs.Sign() > 0 {
+			abs.DivMod(abs, base, mod)
+			digits = append([]rune{n.digits[mod.Int64()]}, digits...)
+		}
+		if len(digits) == 0 {
+			digits = n.digits[:1]
+		}
+	}
+
+	buf := &strings.Builder{}
+	if v.Sign() < 0 {
+		buf.WriteByte('-')
+	}
+	if n.EmitPrefix {
+		buf.WriteString(n.Prefix)
+	}
+	for i, d := range digits {
+		if n.GroupSize > 0 && i > 0 && (len(digits)-i)%n.GroupSize == 0 {
+			buf.WriteString(n.GroupSep)
+		}
+		buf.WriteRune(d)
+	}
+	r = buf.String()
+	return
+}
+
+// returns a codec for base1 (ie. unary) numbers
+//   - a non-negative number n is written as n ones (eg. "111" for 3, and "" for 0)
+//   - evaluates content of at least one digit, so that empty content is not taken as unary
+func Unary(name string) (r *xl8r.Spoke[string, *big.Int]) {
+	check := func(v string) bool {
+		return len(strings.Trim(strings.TrimSpace(v), "1")) == 0
+	}
+	r = &xl8r.Spoke[string, *big.Int]{
+		Id: name,
+		Enc: func(v string, _ ...xl8r.Opts) (r *big.Int, e error) {
+			if !check(v) {
+				e = fmt.Errorf("invalid base 1 number [ %s ]", v)
+				return
+			}
+			r = big.NewInt(int64(utf8.RuneCountInString(strings.TrimSpace(v))))
+			return
+		},
+		Dec: func(v *big.Int, _ ...xl8r.Opts) (r string, e error) {
+			switch {
+			case v == nil:
+				e = fmt.Errorf("nil number")
+			case v.Sign() < 0:
+				e = fmt.Errorf("base 1 can only represent non-negative integers")
+			case v.Cmp(big.NewInt(MaxUnary)) > 0:
+				e = fmt.Errorf("base 1 number too large [ %v ]", v)
+			default:
+				r = strings.Repeat("1", int(v.Int64()))
+			}
+			return
+		},
+		Check: func(v string) bool {
+			return len(strings.TrimSpace(v)) > 0 && check(v)
+		},
+	}
+	return
+}
+
+// returns the codecs of this package
+//   - "base1" (alias "unary") through "base36"
+//   - aliases "binary", "octal", "decimal", "dec", "hex" and "hexadecimal"
+//   - "0b", "0o" and "0x", that produce prefixed numbers
+//   - "base58", "crockford32" and "base62"
+//
+// all numeric codecs accept "_" separators, and bases 2, 8 and 16 accept (optional) "0b", "0o" and "0x" prefixes.
+func Codecs() (r []xl8r.Codec[string, *big.Int]) {
+	aliases := map[int][]string{
+		2:  {"binary"},
+		8:  {"octal"},
+		10: {"decimal", "dec"},
+		16: {"hex", "hexadecimal"},
+	}
+	prefixes := map[int]string{2: "0b", 8: "0o", 16: "0x"}
+
+	r = append(r, Unary("base1"), Unary("unary"))
+	for radix := 2; radix <= len(DigitsBase36); radix++ {
+		names := append([]string{fmt.Sprintf("base%d", radix)}, aliases[radix]...)
+		for _, name := range names {
+			r = append(r, mustBuild(&Base{Id: name, Radix: radix, Prefix: prefixes[radix], Separators: "_"}))
+		}
+		if prefix, exists := prefixes[radix]; exists {
+			r = append(r, mustBuild(&Base{Id: prefix, Radix: radix, Prefix: prefix, EmitPrefix: true, Separators: "_"}))
+		}
+	}
+	r = append(r,
+		mustBuild(&Base{Id: "base58", Alphabet: DigitsBase58}),
+		mustBuild(&Base{
+			Id:              "crockford32",
+			Alphabet:        DigitsCrockford32,
+			CaseInsensitive: true,
+			Equivalents:     map[rune]rune{'O': '0', 'I': '1', 'L': '1'},
+			Separators:      "-",
+		}),
+		mustBuild(&Base{Id: "base62", Alphabet: DigitsBase62}),
+	)
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, *big.Int], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func mustBuild(b *Base) *xl8r.Spoke[string, *big.Int] {
+	r, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
@@ -0,0 +1,150 @@
+package bases
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestBases(t *testing.T) {
+	convertBase, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	huge := "123456789012345678901234567890123456789"
+	tt := []struct {
+		to, from, number, expected string
+		expectedErr                bool
+	}{
+		{to: "hex", from: "decimal", number: "255", expected: "ff"},
+		{to: "0x", from: "decimal", number: "-255", expected: "-0xff"},
+		{to: "binary", from: "hex", number: "0xF0", expected: "11110000"},
+		{to: "0b", from: "octal", number: "0o17", expected: "0b1111"},
+		{to: "decimal", from: "binary", number: "1111_0000", expected: "240"},
+		{to: "base36", from: "decimal", number: "-35", expected: "-z"},
+		{to: "unary", from: "base3", number: "12", expected: "11111"},
+		{to: "decimal", from: "base1", number: "1111", expected: "4"},
+		{to: "hex", from: "decimal", number: huge, expected: "5ce0e9a56015fec5aadfa328ae398115"},
+		{to: "decimal", from: "hex", number: "5CE0E9A56015FEC5AADFA328AE398115", expected: huge},
+		{to: "base62", from: "decimal", number: "61", expected: "z"},
+		{to: "base62", from: "decimal", number: "62", expected: "10"},
+		{to: "base58", from: "decimal", number: "0", expected: "1"},
+		{to: "base58", from: "hex", number: "0x00ff", expected: "5Q"},
+		{to: "decimal", from: "crockford32", number: "1-oi", expected: "1025"},
+		{to: "crockford32", from: "decimal", number: "1057", expected: "111"},
+		{to: "unary", from: "decimal", number: "-1", expectedErr: true},
+		{to: "decimal", from: "binary", number: "102", expectedErr: true},
+		{to: "decimal", from: "hex", number: "0x", expectedErr: true},
+		{to: "decimal", from: "base58", number: "0", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertBase.To(tx.to, tx.from, tx.number)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s") ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.number, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestBaseGrouping(t *testing.T) {
+	grouped, err := (&Base{Id: "grouped", Radix: 10, GroupSize: 3, GroupSep: ","}).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := []struct {
+		number   int64
+		expected string
+	}{
+		{number: 0, expected: "0"},
+		{number: 999, expected: "999"},
+		{number: 1000, expected: "1,000"},
+		{number: -1234567, expected: "-1,234,567"},
+	}
+	for i, tx := range tt {
+		result, dErr := grouped.Decode(big.NewInt(tx.number))
+		if dErr != nil || result != tx.expected {
+			t.Errorf(`# %d: Decode(%d) ==>> "%s" %v, expected "%s"`, i, tx.number, result, dErr, tx.expected)
+		}
+		if hub, eErr := grouped.Encode(result); eErr != nil || hub.Int64() != tx.number {
+			t.Errorf(`# %d: Encode("%s") ==>> %v %v`, i, result, hub, eErr)
+		}
+	}
+}
+
+func TestBaseAlphabet(t *testing.T) {
+	// an alphabet of more digits than big.Int supports
+	runes := []rune{}
+	for c := rune(0x4e00); len(runes) < 100; c++ {
+		runes = append(runes, c)
+	}
+	cjk, err := (&Base{Id: "base100", Alphabet: string(runes)}).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, _ := new(big.Int).SetString("98765432109876543210", 10)
+	text, dErr := cjk.Decode(n)
+	if dErr != nil || len([]rune(text)) != 10 {
+		t.Errorf(`Decode(%v) ==>> "%s" %v`, n, text, dErr)
+	}
+	if hub, eErr := cjk.Encode(text); eErr != nil || hub.Cmp(n) != 0 {
+		t.Errorf(`Encode("%s") ==>> %v %v`, text, hub, eErr)
+	}
+
+	for i, b := range []*Base{
+		{Radix: 10},
+		{Id: "b", Radix: 37},
+		{Id: "b", Radix: 1},
+		{Id: "b", Alphabet: "a"},
+		{Id: "b", Alphabet: "aba"},
+		{Id: "b", Alphabet: "aA", CaseInsensitive: true},
+		{Id: "b", Alphabet: "ab", Equivalents: map[rune]rune{'c': 'd'}},
+		{Id: "b", Alphabet: "ab", Equivalents: map[rune]rune{'b': 'a'}},
+	} {
+		if _, err := b.Build(); err == nil {
+			t.Errorf("# %d: expected an error", i)
+		} else {
+			t.Logf("# %d: %v", i, err)
+		}
+	}
+}
+
+func TestBasesDomain(t *testing.T) {
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+	convertBase, err := xl8r.NewFromNames[string, *big.Int](xl8r.Config{}, Domain, "hex", "base58")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, tErr := convertBase.To("base58", "hex", "ff"); tErr != nil || !strings.EqualFold(result, "5Q") {
+		t.Errorf(`To("base58","hex","ff") ==>> "%s" %v`, result, tErr)
+	}
+}
+
+func TestUnaryEvaluate(t *testing.T) {
+	unary := Unary("unary")
+	tt := []struct {
+		content  string
+		expected bool
+	}{
+		{content: "111", expected: true},
+		{content: " 1 ", expected: true},
+		{content: "", expected: false},
+		{content: "  ", expected: false},
+		{content: "101", expected: false},
+	}
+	for i, tx := range tt {
+		if result := unary.Evaluate(tx.content); result != tx.expected {
+			t.Errorf(`# %d: Evaluate("%s") ==>> %v, expected %v`, i, tx.content, result, tx.expected)
+		}
+	}
+
+	// empty content still encodes as zero
+	if result, err := unary.Encode(""); err != nil || result.Sign() != 0 {
+		t.Errorf(`Encode("") ==>> %v %v, expected 0`, result, err)
+	}
+}
@@ -0,0 +1,160 @@
+package bintext
+
+import (
+	"encoding/ascii85"
+	"fmt"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// returns the specified ascii85 text, without its Adobe delimiters ("<~" and "~>"), if any
+//   - returns bool false, if only one of the delimiters is present
+func trimDelimiters(v string) (r string, ok bool) {
+	r, prefixed := strings.CutPrefix(v, "<~")
+	r, suffixed := strings.CutSuffix(r, "~>")
+	ok = prefixed == suffixed
+	return
+}
+
+func decodeASCII85(v string) (r []byte, e error) {
+	r = make([]byte, 4*len(v))
+	var n int
+	if n, _, e = ascii85.Decode(r, []byte(v), true); e != nil {
+		r = nil
+		return
+	}
+	r = r[:n]
+	return
+}
+
+// returns the codec for ascii85 text, within the Adobe delimiters (eg. "<~87cURDZ~>")
+//   - encoding also accepts text without delimiters (eg. "87cURDZ"), while evaluation does not,
+//     as most printable text is valid ascii85
+//   - groups of 4 zero bytes are written as "z"
+func ASCII85() (r *xl8r.Spoke[string, []byte]) {
+	r = (&scheme{
+		id: "ascii85",
+		read: func(v string) (r []byte, e error) {
+			text, ok := trimDelimiters(stripSpace(v))
+			if !ok {
+				e = fmt.Errorf("unbalanced delimiters")
+				return
+			}
+			r, e = decodeASCII85(text)
+			return
+		},
+		valid: func(v string) (r bool) {
+			text, ok := trimDelimiters(v)
+			if !ok || len(text) == len(v) {
+				return
+			}
+			for _, c := range text {
+				if (c < '!' || c > 'u') && c != 'z' {
+					return
+				}
+			}
+			_, err := decodeASCII85(text)
+			r = err == nil
+			return
+		},
+		write: func(b []byte, _ decodeOptions) (string, error) {
+			text := make([]byte, ascii85.MaxEncodedLen(len(b)))
+			return "<~" + string(text[:ascii85.Encode(text, b)]) + "~>", nil
+		},
+		wraps: true,
+	}).codec()
+	return
+}
+
+// the alphabet of Z85, in the order of digit values
+const z85Alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#"
+
+// the digit values of the Z85 alphabet, by character (-1 for other characters)
+var z85Values = func() (r [256]int) {
+	for i := range r {
+		r[i] = -1
+	}
+	for i := 0; i < len(z85Alphabet); i++ {
+		r[z85Alphabet[i]] = i
+	}
+	return
+}()
+
+// the length, below which purely alphanumeric text is not evaluated as Z85
+const z85MinAlphanumeric = 20
+
+// returns bool true, if the specified text consists only of ASCII letters and digits
+func isAlphanumeric(v string) bool {
+	for i := 0; i < len(v); i++ {
+		if c := v[i]; !(c >= 'A' && c <= 'Z') && !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeZ85(v string) (r []byte, e error) {
+	if len(v)%5 != 0 {
+		e = fmt.Errorf("length is not a multiple of 5")
+		return
+	}
+	r = make([]byte, 0, len(v)/5*4)
+	for i := 0; i < len(v); i += 5 {
+		var value uint64
+		for j := i; j < i+5; j++ {
+			digit := z85Values[v[j]]
+			if digit < 0 {
+				r, e = nil, fmt.Errorf("invalid character at offset %d", j)
+				return
+			}
+			value = value*85 + uint64(digit)
+		}
+		if value > 0xffffffff {
+			r, e = nil, fmt.Errorf("invalid group at offset %d", i)
+			return
+		}
+		r = append(r, byte(value>>24), byte(value>>16), byte(value>>8), byte(value))
+	}
+	return
+}
+
+// returns the codec for Z85 text (eg. "HelloWorld"), as specified by ZeroMQ (ie. RFC 32/Z85)
+//   - decoding returns an error, if the number of bytes is not a multiple of 4
+//   - evaluation rejects purely alphanumeric text shorter than 20 characters (eg. "HelloWorld"),
+//     which is more likely a word or a number, though it is still accepted for encoding
+func Z85() (r *xl8r.Spoke[string, []byte]) {
+	r = (&scheme{
+		id: "z85",
+		read: func(v string) ([]byte, error) {
+			return decodeZ85(stripSpace(v))
+		},
+		valid: func(v string) (r bool) {
+			if len(v) < z85MinAlphanumeric && isAlphanumeric(v) {
+				return
+			}
+			_, err := decodeZ85(v)
+			r = err == nil
+			return
+		},
+		write: func(b []byte, _ decodeOptions) (r string, e error) {
+			if len(b)%4 != 0 {
+				e = fmt.Errorf("z85 length is not a multiple of 4 [ %d ]", len(b))
+				return
+			}
+			text := make([]byte, 0, len(b)/4*5)
+			for i := 0; i < len(b); i += 4 {
+				value := uint32(b[i])<<24 | uint32(b[i+1])<<16 | uint32(b[i+2])<<8 | uint32(b[i+3])
+				var group [5]byte
+				for j := 4; j >= 0; j-- {
+					group[j], value = z85Alphabet[value%85], value/85
+				}
+				text = append(text, group[:]...)
+			}
+			r = string(text)
+			return
+		},
+		wraps: true,
+	}).codec()
+	return
+}
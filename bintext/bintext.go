@@ -0,0 +1,282 @@
+/*
+Package bintext provides codecs for binary-to-text encodings (eg. hex, base64 or quoted-printable),
+with []byte hub data.
+
+	convertBytes, err := bintext.New(xl8r.Config{})
+	b64, err := convertBytes.To("base64", "hex", "48656c6c6f")	// "SGVsbG8="
+
+Note that the codecs are named after the encodings, so that the xl8r Encode of a codec
+decodes its text into bytes (eg. "SGVsbG8=" into "Hello"), and its Decode encodes bytes into text.
+
+Encoding is lenient: whitespace is ignored (where it is not significant),
+and so are missing padding and the case of letters (where the alphabet allows it).
+Evaluation (ie. Check) is strict, so that xl8r.Origins reports only the encodings
+of which the text is a canonical form. Line breaks between lines (eg. of wrapped base64) are accepted,
+while empty text is not. Plain words are not reported either: quoted-printable and percent-encoded
+text needs at least one escaped byte, and Z85 text of letters and digits only needs 20 characters.
+
+Decoding may be tuned with these xl8r.Opts Dec keys:
+  - OptWrap (int): the length of lines, which are separated by "\n" (by default, 0 for no wrapping)
+    (ignored by "quoted-printable", which wraps lines at 76 characters, and by "percent")
+  - OptPadding (bool): whether base32 and base64 text is padded with "="
+    (by default, true, except for "base64raw")
+
+The codecs are also registered under the "bintext" domain (see xl8r.NewFromDomain).
+*/
+package bintext
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "bintext"
+
+// the xl8r.Opts Dec keys, read by the decoders of this package
+const (
+	OptWrap    = "wrap"
+	OptPadding = "padding"
+)
+
+// the options of a single decoding
+type decodeOptions struct {
+	wrap    int
+	padding bool
+}
+
+// returns the options of decoding, with the specified default padding
+func readOptions(opts0 []xl8r.Opts, padding bool) (r decodeOptions, e error) {
+	r = decodeOptions{padding: padding}
+	if len(opts0) == 0 {
+		return
+	}
+	decoderOpts := opts0[0].Dec
+	if xValue, exists := decoderOpts[OptWrap]; exists {
+		wrap, ok := xValue.(int)
+		if !ok || wrap < 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptWrap, xValue)
+			return
+		}
+		r.wrap = wrap
+	}
+	if xValue, exists := decoderOpts[OptPadding]; exists {
+		if r.padding, exists = xValue.(bool); !exists {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptPadding, xValue)
+			return
+		}
+	}
+	return
+}
+
+// returns the specified text, without whitespace
+func stripSpace(v string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, v)
+}
+
+// returns the specified text, without the line breaks between its lines
+func stripBreaks(v string) string {
+	return strings.NewReplacer("\r\n", "", "\n", "").Replace(v)
+}
+
+// returns the specified text, in lines of the specified length (0 for a single line)
+func wrap(v string, length int) string {
+	if length <= 0 || len(v) <= length {
+		return v
+	}
+	var lines []string
+	for len(v) > length {
+		lines, v = append(lines, v[:length]), v[length:]
+	}
+	return strings.Join(append(lines, v), "\n")
+}
+
+// a binary-to-text encoding
+type scheme struct {
+	id string
+	// converts the specified text into bytes, leniently
+	read func(v string) (r []byte, e error)
+	// returns bool true, if the specified text is strictly valid
+	valid func(v string) (r bool)
+	// converts the specified bytes into text
+	write func(b []byte, o decodeOptions) (r string, e error)
+	// when bool true, the text may be wrapped in lines (see OptWrap),
+	// and is evaluated without its line breaks
+	wraps bool
+	// when bool true, the text is padded by default (see OptPadding)
+	padding bool
+}
+
+func (s *scheme) codec() (r *xl8r.Spoke[string, []byte]) {
+	r = &xl8r.Spoke[string, []byte]{
+		Id: s.id,
+		Enc: func(v string, _ ...xl8r.Opts) (r []byte, e error) {
+			if r, e = s.read(v); e != nil {
+				e = fmt.Errorf("invalid %s text [ %s ]: %w", s.id, v, e)
+			}
+			return
+		},
+		Dec: func(v []byte, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(opts0, s.padding); e != nil {
+				return
+			}
+			if r, e = s.write(v, o); e == nil && s.wraps {
+				r = wrap(r, o.wrap)
+			}
+			return
+		},
+		Check: func(v string) (r bool) {
+			text := v
+			if s.wraps {
+				text = stripBreaks(v)
+			}
+			r = len(text) > 0 && s.valid(text)
+			return
+		},
+	}
+	return
+}
+
+// returns the codec for hexadecimal text (eg. "48656c6c6f"), written in lower case
+func Hex() (r *xl8r.Spoke[string, []byte]) {
+	r = (&scheme{
+		id: "hex",
+		read: func(v string) ([]byte, error) {
+			return hex.DecodeString(stripSpace(v))
+		},
+		valid: func(v string) (r bool) {
+			_, err := hex.DecodeString(v)
+			r = err == nil
+			return
+		},
+		write: func(b []byte, _ decodeOptions) (string, error) {
+			return hex.EncodeToString(b), nil
+		},
+		wraps: true,
+	}).codec()
+	return
+}
+
+// returns a base32 scheme, of the specified alphabet
+func base32Scheme(id string, encoding *base32.Encoding) (r *scheme) {
+	raw := encoding.WithPadding(base32.NoPadding)
+	r = &scheme{
+		id: id,
+		read: func(v string) ([]byte, error) {
+			return raw.DecodeString(strings.TrimRight(strings.ToUpper(stripSpace(v)), "="))
+		},
+		valid: func(v string) (r bool) {
+			// the canonical text, either padded or not
+			for _, e := range []*base32.Encoding{encoding, raw} {
+				if b, err := e.DecodeString(v); err == nil && e.EncodeToString(b) == v {
+					r = true
+				}
+			}
+			return
+		},
+		write: func(b []byte, o decodeOptions) (string, error) {
+			if o.padding {
+				return encoding.EncodeToString(b), nil
+			}
+			return raw.EncodeToString(b), nil
+		},
+		wraps:   true,
+		padding: true,
+	}
+	return
+}
+
+// returns the codec for base32 text (eg. "JBSWY3DP"), of the alphabet of RFC 4648
+func Base32() (r *xl8r.Spoke[string, []byte]) {
+	r = base32Scheme("base32", base32.StdEncoding).codec()
+	return
+}
+
+// returns the codec for base32 text (eg. "91IMOR3F"), of the "extended hex" alphabet of RFC 4648
+func Base32Hex() (r *xl8r.Spoke[string, []byte]) {
+	r = base32Scheme("base32hex", base32.HexEncoding).codec()
+	return
+}
+
+// returns a base64 scheme, of the specified alphabet
+//   - the text is evaluated, if it is canonical with any of the specified paddings
+func base64Scheme(id string, encoding *base64.Encoding, padding bool, paddings ...rune) (r *scheme) {
+	raw := encoding.WithPadding(base64.NoPadding)
+	r = &scheme{
+		id: id,
+		read: func(v string) ([]byte, error) {
+			return raw.DecodeString(strings.TrimRight(stripSpace(v), "="))
+		},
+		valid: func(v string) (r bool) {
+			for _, p := range paddings {
+				_, err := encoding.WithPadding(p).Strict().DecodeString(v)
+				r = r || err == nil
+			}
+			return
+		},
+		write: func(b []byte, o decodeOptions) (string, error) {
+			if o.padding {
+				return encoding.WithPadding(base64.StdPadding).EncodeToString(b), nil
+			}
+			return raw.EncodeToString(b), nil
+		},
+		wraps:   true,
+		padding: padding,
+	}
+	return
+}
+
+// returns the codec for base64 text (eg. "SGVsbG8="), of the standard alphabet of RFC 4648
+//   - evaluates padded text only
+func Base64() (r *xl8r.Spoke[string, []byte]) {
+	r = base64Scheme("base64", base64.StdEncoding, true, base64.StdPadding).codec()
+	return
+}
+
+// returns the codec for base64 text (eg. "-_8="), of the URL and file name safe alphabet of RFC 4648
+//   - evaluates padded and unpadded text (eg. of JSON Web Tokens)
+func Base64URL() (r *xl8r.Spoke[string, []byte]) {
+	r = base64Scheme("base64url", base64.URLEncoding, true, base64.StdPadding, base64.NoPadding).codec()
+	return
+}
+
+// returns the codec for unpadded base64 text (eg. "SGVsbG8"), of the standard alphabet of RFC 4648
+//   - evaluates unpadded text only
+func Base64Raw() (r *xl8r.Spoke[string, []byte]) {
+	r = base64Scheme("base64raw", base64.StdEncoding, false, base64.NoPadding).codec()
+	return
+}
+
+// returns the codecs of this package: "hex", "base32", "base32hex", "base64", "base64url",
+// "base64raw", "ascii85", "z85", "quoted-printable" and "percent"
+func Codecs() (r []xl8r.Codec[string, []byte]) {
+	r = []xl8r.Codec[string, []byte]{
+		Hex(), Base32(), Base32Hex(), Base64(), Base64URL(), Base64Raw(),
+		ASCII85(), Z85(), QuotedPrintable(), Percent(),
+	}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, []byte], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
@@ -0,0 +1,145 @@
+package bintext
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestBinText(t *testing.T) {
+	convertBytes, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := func(kv ...any) xl8r.Opts {
+		dec := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			dec[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Dec: dec}
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		opts                     xl8r.Opts
+		expectedErr              bool
+	}{
+		{to: "base64", from: "hex", text: "48656c6c6f", expected: "SGVsbG8="},
+		{to: "hex", from: "base64", text: "SGVsbG8", expected: "48656c6c6f"},
+		{to: "hex", from: "base64", text: "SGVs\r\nbG8=\n", expected: "48656c6c6f"},
+		{to: "base64", from: "hex", text: "48 65 6C 6C 6F", opts: opts(OptPadding, false), expected: "SGVsbG8"},
+		{to: "base64raw", from: "hex", text: "48656c6c6f", expected: "SGVsbG8"},
+		{to: "base64raw", from: "hex", text: "48656c6c6f", opts: opts(OptPadding, true), expected: "SGVsbG8="},
+		{to: "base64url", from: "hex", text: "fbff", expected: "-_8="},
+		{to: "base64", from: "base64url", text: "-_8", expected: "+/8="},
+		{to: "base32", from: "hex", text: "48656c6c6f", expected: "JBSWY3DP"},
+		{to: "base32", from: "hex", text: "48656c6c", expected: "JBSWY3A="},
+		{to: "base32", from: "hex", text: "48656c6c", opts: opts(OptPadding, false), expected: "JBSWY3A"},
+		{to: "base32hex", from: "base32", text: "jbswy3dp", expected: "91IMOR3F"},
+		{to: "hex", from: "base32hex", text: "91IMOR3F", expected: "48656c6c6f"},
+		{to: "ascii85", from: "hex", text: "48656c6c6f", expected: "<~87cURDZ~>"},
+		{to: "hex", from: "ascii85", text: "87cURDZ", expected: "48656c6c6f"},
+		{to: "hex", from: "ascii85", text: "<~87cURDZ~\n>", expected: "48656c6c6f"},
+		{to: "hex", from: "ascii85", text: "<~87cURDZ~>", expected: "48656c6c6f"},
+		{to: "ascii85", from: "hex", text: "00000000", expected: "<~z~>"},
+		{to: "z85", from: "hex", text: "864fd26fb559f75b", expected: "HelloWorld"},
+		{to: "hex", from: "z85", text: "HelloWorld", expected: "864fd26fb559f75b"},
+		{to: "quoted-printable", from: "percent", text: "caf%C3%A9", expected: "caf=C3=A9"},
+		{to: "percent", from: "quoted-printable", text: "caf=c3=a9 au lait", expected: "caf%C3%A9%20au%20lait"},
+		{to: "quoted-printable", from: "percent", text: "a%0Ab", expected: "a=0Ab"},
+		{to: "percent", from: "percent", text: "a/b+c", expected: "a%2Fb%2Bc"},
+		{to: "base64", from: "hex", text: "48656c6c6f48656c6c6f", opts: opts(OptWrap, 8), expected: "SGVsbG9I\nZWxsbw=="},
+		{to: "hex", from: "hex", text: "48656c6c6f", opts: opts(OptWrap, 4), expected: "4865\n6c6c\n6f"},
+		{to: "percent", from: "hex", text: "48656c6c6f", opts: opts(OptWrap, 2), expected: "Hello"},
+		{to: "hex", from: "hex", text: "", expected: ""},
+		{to: "hex", from: "hex", text: "486", expectedErr: true},
+		{to: "hex", from: "base64", text: "SGVsbG8$", expectedErr: true},
+		{to: "hex", from: "base64", text: "-_8=", expectedErr: true},
+		{to: "hex", from: "ascii85", text: "<~87cURDZ", expectedErr: true},
+		{to: "hex", from: "z85", text: "Hell~", expectedErr: true},
+		{to: "hex", from: "z85", text: "#####", expectedErr: true},
+		{to: "hex", from: "z85", text: "Hell", expectedErr: true},
+		{to: "z85", from: "hex", text: "48656c", expectedErr: true},
+		{to: "hex", from: "percent", text: "caf%C", expectedErr: true},
+		{to: "hex", from: "hex", text: "48", opts: opts(OptWrap, -1), expectedErr: true},
+		{to: "hex", from: "hex", text: "48", opts: opts(OptPadding, "no"), expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertBytes.To(tx.to, tx.from, tx.text, tx.opts)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s",%v) ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, tx.opts.Dec, result, tErr, tx.expected)
+		}
+	}
+
+	long := make([]byte, 100)
+	for i := range long {
+		long[i] = byte(i)
+	}
+	for _, c := range Codecs() {
+		if c.Name() == "z85" {
+			continue
+		}
+		text, dErr := c.Decode(long, opts(OptWrap, 20))
+		if dErr != nil || !c.Evaluate(text) {
+			t.Errorf(`%s: Decode(..) ==>> "%s" %v, which does not evaluate`, c.Name(), text, dErr)
+			continue
+		}
+		if back, eErr := c.Encode(text); eErr != nil || string(back) != string(long) {
+			t.Errorf(`%s: Encode("%s") ==>> %v %v`, c.Name(), text, back, eErr)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	convertBytes, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt := []struct {
+		text     string
+		expected []string
+	}{
+		{"SGVsbG8=", []string{"base64", "base64url"}},
+		{"SGVsbG8", []string{"base64raw", "base64url"}},
+		{"+/8=", []string{"base64"}},
+		{"-_8", []string{"base64url"}},
+		{"JBSWY3DP", []string{"base32", "base64", "base64raw", "base64url"}},
+		{"JBSWY3A=", []string{"base32", "base64", "base64url"}},
+		{"<~87cURDZ~>", []string{"ascii85"}},
+		{"87cURDZ", nil},
+		{"caf=C3=A9", []string{"quoted-printable"}},
+		{"caf%C3%A9", []string{"percent"}},
+		{"caf%c3%a9", []string{"percent"}},
+		{"HelloWorld", nil},
+		{"HelloWorldHelloWorld", []string{"base64", "base64raw", "base64url", "z85"}},
+		{"Hello.World", nil},
+		{"48656c6c6f", []string{"hex"}},
+		{"deadbeef", []string{"base64", "base64raw", "base64url", "hex"}},
+		{"12345", nil},
+		{"hello world", nil},
+		{"jbswy3dp", []string{"base64", "base64raw", "base64url"}},
+		{"caf=c3=a9", nil},
+		{"", nil},
+	}
+	for i, tx := range tt {
+		result := convertBytes.Origins(tx.text)
+		sort.Strings(result)
+		if len(result) != len(tx.expected) {
+			t.Errorf(`# %d: Origins("%s") ==>> %v, expected %v`, i, tx.text, result, tx.expected)
+			continue
+		}
+		for j := range result {
+			if result[j] != tx.expected[j] {
+				t.Errorf(`# %d: Origins("%s") ==>> %v, expected %v`, i, tx.text, result, tx.expected)
+				break
+			}
+		}
+	}
+
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+}
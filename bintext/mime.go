@@ -0,0 +1,128 @@
+package bintext
+
+import (
+	"fmt"
+	"io"
+	"mime/quotedprintable"
+	"net/url"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the maximum length of a line of quoted-printable text, without its line break
+const qpLineLength = 76
+
+// returns bool true, if the specified character is an upper-case hexadecimal digit
+func isUpperHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'F')
+}
+
+// returns bool true, if the specified character is a hexadecimal digit, of either case
+func isHexDigit(c byte) bool {
+	return isUpperHex(c) || (c >= 'a' && c <= 'f')
+}
+
+// returns bool true, if the specified text is valid quoted-printable, as written by RFC 2045
+//   - lines are at most 76 characters long, and do not end with a space or a tab
+//   - "=" precedes two upper-case hexadecimal digits, or ends a line (ie. a soft line break)
+func validQuotedPrintable(v string) (r bool) {
+	escaped := false
+	for _, line := range strings.Split(strings.ReplaceAll(v, "\r\n", "\n"), "\n") {
+		if len(line) > qpLineLength || strings.HasSuffix(line, " ") || strings.HasSuffix(line, "\t") {
+			return
+		}
+		for i := 0; i < len(line); i++ {
+			switch c := line[i]; {
+			case c == '=':
+				if i == len(line)-1 {
+					break
+				}
+				if i+2 >= len(line) || !isUpperHex(line[i+1]) || !isUpperHex(line[i+2]) {
+					return
+				}
+				i, escaped = i+2, true
+			case c != ' ' && c != '\t' && (c < '!' || c > '~'):
+				return
+			}
+		}
+	}
+	r = escaped
+	return
+}
+
+// returns the codec for quoted-printable text (eg. "caf=C3=A9"), as specified by RFC 2045
+//   - line breaks of the bytes are encoded (eg. "=0D=0A"), so that any bytes are written without loss
+//   - lines are wrapped at 76 characters, with soft line breaks
+//   - evaluation requires at least one escaped byte (eg. "=C3"), so that plain text is not reported
+func QuotedPrintable() (r *xl8r.Spoke[string, []byte]) {
+	r = (&scheme{
+		id: "quoted-printable",
+		read: func(v string) ([]byte, error) {
+			return io.ReadAll(quotedprintable.NewReader(strings.NewReader(v)))
+		},
+		valid: validQuotedPrintable,
+		write: func(b []byte, _ decodeOptions) (r string, e error) {
+			var text strings.Builder
+			w := quotedprintable.NewWriter(&text)
+			w.Binary = true
+			if _, e = w.Write(b); e == nil {
+				e = w.Close()
+			}
+			r = text.String()
+			return
+		},
+	}).codec()
+	return
+}
+
+// returns bool true, if the specified character is unreserved by RFC 3986 (ie. written as it is)
+func isUnreserved(c byte) bool {
+	return (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// returns the codec for percent-encoded text (eg. "caf%C3%A9%20au%20lait"), as specified by RFC 3986
+//   - all bytes but unreserved characters are written as "%XX"
+//   - encoding also accepts reserved characters as they are (eg. "a/b"), but not "+" for a space
+//   - evaluation requires at least one escaped byte (eg. "%C3"), so that plain text is not reported
+func Percent() (r *xl8r.Spoke[string, []byte]) {
+	r = (&scheme{
+		id: "percent",
+		read: func(v string) (r []byte, e error) {
+			var text string
+			if text, e = url.PathUnescape(v); e == nil {
+				r = []byte(text)
+			}
+			return
+		},
+		valid: func(v string) (r bool) {
+			escaped := false
+			for i := 0; i < len(v); i++ {
+				switch c := v[i]; {
+				case c == '%':
+					if i+2 >= len(v) || !isHexDigit(v[i+1]) || !isHexDigit(v[i+2]) {
+						return
+					}
+					i, escaped = i+2, true
+				case !isUnreserved(c):
+					return
+				}
+			}
+			r = escaped
+			return
+		},
+		write: func(b []byte, _ decodeOptions) (string, error) {
+			var text strings.Builder
+			for _, c := range b {
+				if isUnreserved(c) {
+					text.WriteByte(c)
+				} else {
+					fmt.Fprintf(&text, "%%%02X", c)
+				}
+			}
+			return text.String(), nil
+		},
+	}).codec()
+	return
+}
@@ -0,0 +1,177 @@
+package xl8r
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// settings for the circuit breaker of a codec
+//   - the zero value disables the circuit breaker
+type BreakerPolicy struct {
+	// number of consecutive failures that opens the circuit
+	//   - zero or less disables the circuit breaker
+	Threshold int
+	// how long the circuit stays open, before a trial call is let through
+	Cooldown time.Duration
+	// optional function that returns bool true, if the specified error counts as a failure
+	//   - nil means every error counts as a failure
+	Counts func(e error) bool
+}
+
+// the state of a circuit breaker
+type BreakerState string
+
+const (
+	// calls are let through
+	BreakerClosed BreakerState = "closed"
+	// calls are refused, with a *CircuitOpenError
+	BreakerOpen BreakerState = "open"
+	// the cooldown has passed, and a single trial call is let through
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+// a snapshot of a codec's circuit breaker
+type BreakerStatus struct {
+	// name of the codec
+	Codec string
+	// state of the circuit
+	State BreakerState
+	// number of consecutive failures
+	Failures int
+	// when the circuit was last opened
+	OpenedAt time.Time
+}
+
+// the error returned when a codec's circuit breaker refuses a call
+type CircuitOpenError struct {
+	// name of the codec
+	Codec string
+	// the stage of the translation, that was refused
+	Stage Stage
+	// when the circuit will let a trial call through
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit open [ '%s' %s ]: retry at %s", e.Codec, e.Stage, e.RetryAt.Format(time.RFC3339))
+}
+
+// a source of time, for retries and circuit breakers
+//   - tests may supply a fake, so that no real time needs to pass
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// the circuit breaker of a single codec
+type breaker struct {
+	policy BreakerPolicy
+	clock  Clock
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	// bool true, while a trial call is running in the half-open state
+	probing bool
+}
+
+func newBreakers(policies map[string]Policy, clock Clock) (r map[string]*breaker) {
+	r = make(map[string]*breaker)
+	for name, p := range policies {
+		if p.Breaker.Threshold > 0 {
+			r[name] = &breaker{policy: p.Breaker, clock: clock, state: BreakerClosed}
+		}
+	}
+	return
+}
+
+// returns a non-nil error, if the circuit refuses the call
+func (b *breaker) allow(name string, stage Stage) (e error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	retryAt := b.openedAt.Add(b.policy.Cooldown)
+	switch b.state {
+	case BreakerOpen:
+		if b.clock.Now().Before(retryAt) {
+			break
+		}
+		b.state = BreakerHalfOpen
+		fallthrough
+	case BreakerHalfOpen:
+		if !b.probing {
+			b.probing = true
+			return
+		}
+	default:
+		return
+	}
+	e = &CircuitOpenError{Codec: name, Stage: stage, RetryAt: retryAt}
+	return
+}
+
+// records the outcome of a call that the circuit let through
+func (b *breaker) record(e error) {
+	b.settle(e != nil && (b.policy.Counts == nil || b.policy.Counts(e)))
+}
+
+// makes a call that the circuit let through, and records its outcome
+//   - a panic (ie. not in SafeMode) counts as a failure, before it reaches the caller,
+//     so that a panicking trial call does not leave the circuit half-open for good
+func recordCall[T any](b *breaker, f func() (T, error)) (r T, e error) {
+	returned := false
+	defer func() {
+		if !returned {
+			b.settle(true)
+		}
+	}()
+	r, e = f()
+	returned = true
+	b.record(e)
+	return
+}
+
+// settles the state of the circuit, after a call that it let through
+func (b *breaker) settle(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasProbing := b.probing
+	b.probing = false
+
+	if !failed {
+		b.state = BreakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if wasProbing || b.failures >= b.policy.Threshold {
+		b.state = BreakerOpen
+		b.openedAt = b.clock.Now()
+	}
+}
+
+func (b *breaker) status(name string) (r BreakerStatus) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r = BreakerStatus{
+		Codec:    name,
+		State:    b.state,
+		Failures: b.failures,
+		OpenedAt: b.openedAt,
+	}
+	if r.State == BreakerOpen && !b.clock.Now().Before(b.openedAt.Add(b.policy.Cooldown)) {
+		r.State = BreakerHalfOpen
+	}
+	return
+}
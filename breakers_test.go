@@ -0,0 +1,287 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// a fake Clock, whose time only passes when it sleeps (or is advanced)
+type testClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Sleep(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// a codec whose encoder returns the queued errors, before it succeeds
+type testFlakySpoke struct {
+	Spoke[string, string]
+	mu     sync.Mutex
+	errs   []error
+	called int
+}
+
+func newTestFlakySpoke(id string, errs ...error) (r *testFlakySpoke) {
+	r = &testFlakySpoke{errs: errs}
+	r.Spoke = Spoke[string, string]{
+		Id: id,
+		Enc: func(v string, _ ...Opts) (s string, e error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.called++
+			if len(r.errs) > 0 {
+				e, r.errs = r.errs[0], r.errs[1:]
+				return
+			}
+			s = v
+			return
+		},
+		Dec:   func(v string, _ ...Opts) (string, error) { return v, nil },
+		Check: func(v string) bool { return true },
+	}
+	return
+}
+
+func (s *testFlakySpoke) fail(errs ...error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, errs...)
+}
+
+func TestRetryTemporaryErrors(t *testing.T) {
+	clock := &testClock{}
+	tempErr := Temporary(fmt.Errorf("daemon unavailable"))
+	flaky := newTestFlakySpoke("flaky", tempErr, tempErr)
+
+	translate, err := NewWithConfig[string, string](Config{
+		Clock: clock,
+		Policies: map[string]Policy{"flaky": {
+			Retry: RetryPolicy{MaxAttempts: 3, Backoff: ExponentialBackoff(10*time.Millisecond, time.Second)},
+		}},
+	}, flaky, testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	result, tErr := translate.To("calm", "flaky", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "x", result)
+	assrtEqual(t, 3, flaky.called)
+	assrtEqual(t, []time.Duration{10 * time.Millisecond, 20 * time.Millisecond}, clock.sleeps)
+
+	// attempts are limited
+	flaky.called = 0
+	flaky.fail(tempErr, tempErr, tempErr, tempErr)
+	_, tErr = translate.Encode("flaky", "x")
+	assrtEqual(t, tempErr, tErr)
+	assrtEqual(t, 3, flaky.called)
+
+	// errors that are not temporary, are not retried
+	flaky.called = 0
+	flaky.errs = nil
+	permanentErr := fmt.Errorf("unknown word")
+	flaky.fail(permanentErr)
+	_, tErr = translate.Encode("flaky", "x")
+	assrtEqual(t, permanentErr, tErr)
+	assrtEqual(t, 1, flaky.called)
+}
+
+func TestIsTemporary(t *testing.T) {
+	assrtNil(t, Temporary(nil))
+	assrtTrue(t, IsTemporary(Temporary(fmt.Errorf("x"))))
+	assrtTrue(t, IsTemporary(fmt.Errorf("wrapped: %w", Temporary(fmt.Errorf("x")))))
+	assrtTrue(t, IsTemporary(&CodecBusyError{}))
+	assrtTrue(t, IsTemporary(&CodecTimeoutError{}))
+	assrtFalse(t, IsTemporary(fmt.Errorf("x")))
+	assrtFalse(t, IsTemporary(&CodecPanicError{}))
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(100*time.Millisecond, time.Second)
+	for i, expected := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		assrtEqual(t, expected*time.Millisecond, backoff(i+1))
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	clock := &testClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	failure := fmt.Errorf("daemon crashed")
+	flaky := newTestFlakySpoke("flaky")
+
+	translate, err := NewWithConfig[string, string](Config{
+		Clock: clock,
+		Policies: map[string]Policy{"flaky": {
+			Breaker: BreakerPolicy{Threshold: 2, Cooldown: time.Minute},
+		}},
+	}, flaky, testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	_, hasBreaker := translate.Breaker("calm")
+	assrtFalse(t, hasBreaker)
+
+	status, hasBreaker := translate.Breaker("flaky")
+	assrtTrue(t, hasBreaker)
+	assrtEqual(t, BreakerClosed, status.State)
+
+	// repeated failures open the circuit
+	flaky.fail(failure, failure)
+	for i := 0; i < 2; i++ {
+		_, tErr := translate.Encode("flaky", "x")
+		assrtEqual(t, failure, tErr)
+	}
+	status, _ = translate.Breaker("flaky")
+	assrtEqual(t, BreakerStatus{Codec: "flaky", State: BreakerOpen, Failures: 2, OpenedAt: clock.Now()}, status)
+
+	// an open circuit refuses calls, without calling the codec
+	_, tErr := translate.To("calm", "flaky", "x")
+	var openErr *CircuitOpenError
+	assrtTrue(t, errors.As(tErr, &openErr))
+	assrtEqual(t, 2, flaky.called)
+	t.Log(tErr)
+
+	// after the cooldown, a failed trial call re-opens the circuit
+	clock.Advance(time.Minute)
+	status, _ = translate.Breaker("flaky")
+	assrtEqual(t, BreakerHalfOpen, status.State)
+	flaky.fail(failure)
+	_, tErr = translate.Encode("flaky", "x")
+	assrtEqual(t, failure, tErr)
+	status, _ = translate.Breaker("flaky")
+	assrtEqual(t, BreakerOpen, status.State)
+
+	// and a successful trial call closes it
+	clock.Advance(time.Minute)
+	result, tErr := translate.Encode("flaky", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "x", result)
+	status, _ = translate.Breaker("flaky")
+	assrtEqual(t, BreakerClosed, status.State)
+	assrtEqual(t, 0, status.Failures)
+}
+
+func TestCircuitBreakerCounts(t *testing.T) {
+	flaky := newTestFlakySpoke("flaky")
+	translate, err := NewWithConfig[string, string](Config{
+		Clock: &testClock{},
+		Policies: map[string]Policy{"flaky": {
+			Breaker: BreakerPolicy{Threshold: 1, Cooldown: time.Minute, Counts: IsTemporary},
+		}},
+	}, flaky, testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	flaky.fail(fmt.Errorf("bad content"))
+	translate.Encode("flaky", "x")
+	status, _ := translate.Breaker("flaky")
+	assrtEqual(t, BreakerClosed, status.State)
+
+	flaky.fail(Temporary(fmt.Errorf("daemon unavailable")))
+	translate.Encode("flaky", "x")
+	status, _ = translate.Breaker("flaky")
+	assrtEqual(t, BreakerOpen, status.State)
+}
+
+func TestCircuitBreakerIgnoresEvaluate(t *testing.T) {
+	clock := &testClock{}
+	failure := fmt.Errorf("daemon crashed")
+	flaky := newTestFlakySpoke("flaky")
+	translate, err := NewWithConfig[string, string](Config{
+		Clock: clock,
+		Policies: map[string]Policy{"flaky": {
+			Breaker: BreakerPolicy{Threshold: 2, Cooldown: time.Minute},
+		}},
+	}, flaky, testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	// evaluations do not reset the failures
+	flaky.fail(failure)
+	translate.Encode("flaky", "x")
+	translate.Origins("x")
+	status, _ := translate.Breaker("flaky")
+	assrtEqual(t, 1, status.Failures)
+
+	// nor close a half-open circuit
+	flaky.fail(failure)
+	translate.Encode("flaky", "x")
+	clock.Advance(time.Minute)
+	translate.Origins("x")
+	status, _ = translate.Breaker("flaky")
+	assrtEqual(t, BreakerHalfOpen, status.State)
+	assrtEqual(t, 2, status.Failures)
+
+	// and the trial call is still let through
+	_, tErr := translate.Encode("flaky", "x")
+	assrtNil(t, tErr)
+	status, _ = translate.Breaker("flaky")
+	assrtEqual(t, BreakerClosed, status.State)
+}
+
+func TestCircuitBreakerPanics(t *testing.T) {
+	clock := &testClock{}
+	panics := true
+	boom := &Spoke[string, string]{
+		Id: "boom",
+		Enc: func(v string, _ ...Opts) (r string, e error) {
+			if panics {
+				panic("encoder blew up")
+			}
+			r = v
+			return
+		},
+		Dec:   func(v string, _ ...Opts) (string, error) { return v, nil },
+		Check: func(v string) bool { return true },
+	}
+	translate, err := NewWithConfig[string, string](Config{
+		Clock: clock,
+		Policies: map[string]Policy{"boom": {
+			Breaker: BreakerPolicy{Threshold: 1, Cooldown: time.Minute},
+		}},
+	}, boom, testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	encode := func() (v any) {
+		defer func() { v = recover() }()
+		translate.Encode("boom", "x")
+		return
+	}
+
+	// a panic (not in SafeMode) counts as a failure
+	assrtEqual(t, "encoder blew up", encode())
+	status, _ := translate.Breaker("boom")
+	assrtEqual(t, BreakerOpen, status.State)
+	assrtEqual(t, 1, status.Failures)
+
+	// a panicking trial call re-opens the circuit
+	clock.Advance(time.Minute)
+	assrtEqual(t, "encoder blew up", encode())
+	status, _ = translate.Breaker("boom")
+	assrtEqual(t, BreakerOpen, status.State)
+	assrtEqual(t, clock.Now(), status.OpenedAt)
+
+	// and does not keep the next trial call from being let through
+	panics = false
+	clock.Advance(time.Minute)
+	result, tErr := translate.Encode("boom", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "x", result)
+	status, _ = translate.Breaker("boom")
+	assrtEqual(t, BreakerClosed, status.State)
+}
@@ -29,12 +29,20 @@ func codecIsValid[P, H any](c Codec[P, H]) (r bool) {
 
 	if spoke, isSpoke := c.(*Spoke[P, H]); isSpoke {
 		r = len(spoke.Id) > 0 &&
+			versionIsValid(spoke.Id) &&
 			spoke.Enc != nil &&
 			spoke.Dec != nil &&
 			spoke.Check != nil
 		return
 	}
 
-	r = len(c.Name()) > 0
+	if lazy, isLazy := c.(*Lazy[P, H]); isLazy {
+		r = len(lazy.Id) > 0 &&
+			versionIsValid(lazy.Id) &&
+			lazy.Factory != nil
+		return
+	}
+
+	r = len(c.Name()) > 0 && versionIsValid(c.Name())
 	return
 }
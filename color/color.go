@@ -0,0 +1,309 @@
+/*
+Package color provides codecs for colors written in CSS and other notations
+(eg. "#ff8000", "rgb(255 128 0)", "hsl(30 100% 50%)", "orange" or "lab(75.59 27.52 79.12)"),
+with Color hub data: linear-light sRGB components and an alpha.
+
+	convertColor, err := color.New(xl8r.Config{})
+	hsl, err := convertColor.To("hsl", "hex", "#ff8000")	// "hsl(30.12 100% 50%)"
+
+Colors are written in the modern CSS syntax (eg. "rgb(255 128 0 / 0.5)"), and encoding also accepts
+the legacy syntax (eg. "rgba(255, 128, 0, 0.5)"). The "hsv" and "cmyk" codecs follow the same syntax,
+though they are not CSS. The "lab" codec reads and writes CIE Lab, as CSS does (ie. relative to D50).
+
+Hub data is not limited to the sRGB gamut, so that colors may be translated from Lab, and back, without loss.
+
+Decoding may be tuned with these xl8r.Opts Dec keys:
+  - OptGamut (Gamut): how colors outside the sRGB gamut are written (by default, GamutClip)
+  - OptPrecision (int): the number of decimal places of numbers (by default, as many as needed, up to 2)
+  - OptNearest (bool): when true, the "named" codec writes the nearest named color,
+    rather than returning an error for colors without name
+
+The codecs are also registered under the "color" domain (see xl8r.NewFromDomain).
+*/
+package color
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "color"
+
+// the xl8r.Opts Dec keys, read by the decoders of this package
+const (
+	OptGamut     = "gamut"
+	OptPrecision = "precision"
+	OptNearest   = "nearest"
+)
+
+// how colors outside the sRGB gamut are decoded, into notations based on sRGB
+type Gamut string
+
+const (
+	// clamps each sRGB component into its range
+	GamutClip Gamut = "clip"
+	// returns an error
+	GamutReject Gamut = "reject"
+	// writes components out of their range (eg. "rgb(300 -20 0)"), where the notation allows it
+	//   - "hex", "named", "cmyk", "hsl" and "hsv" clip colors, as with GamutClip
+	GamutNone Gamut = "none"
+)
+
+// the number of decimal places used, when no precision is specified
+// (trailing zeros are dropped)
+const defaultPrecision = 2
+
+// the tolerance of gamut checks, for the rounding errors of conversions
+const gamutTolerance = 1e-9
+
+// a color (ie. the hub data of this package)
+type Color struct {
+	// the linear-light sRGB components (ie. without gamma), outside [0, 1] for colors out of gamut
+	R, G, B float64
+	// the opacity, from 0 (transparent) to 1 (opaque)
+	Alpha float64
+}
+
+// returns the linear-light value of the specified gamma-encoded sRGB component
+func linearize(c float64) float64 {
+	if a := math.Abs(c); a > 0.04045 {
+		return math.Copysign(math.Pow((a+0.055)/1.055, 2.4), c)
+	}
+	return c / 12.92
+}
+
+// returns the gamma-encoded value of the specified linear-light sRGB component
+func gammaEncode(c float64) float64 {
+	if a := math.Abs(c); a > 0.0031308 {
+		return math.Copysign(1.055*math.Pow(a, 1/2.4)-0.055, c)
+	}
+	return c * 12.92
+}
+
+// returns the color of the specified gamma-encoded sRGB components (eg. 1, 0.5, 0 for "#ff8000")
+func FromSRGB(r, g, b, alpha float64) (c Color) {
+	c = Color{R: linearize(r), G: linearize(g), B: linearize(b), Alpha: alpha}
+	return
+}
+
+// returns the gamma-encoded sRGB components of the color
+func (c Color) SRGB() (r, g, b float64) {
+	r, g, b = gammaEncode(c.R), gammaEncode(c.G), gammaEncode(c.B)
+	return
+}
+
+// returns bool true, if the color is within the sRGB gamut
+func (c Color) InGamut() (r bool) {
+	r = true
+	for _, x := range []float64{c.R, c.G, c.B} {
+		r = r && x >= -gamutTolerance && x <= 1+gamutTolerance
+	}
+	return
+}
+
+// a 3x3 matrix, of color space conversions
+type matrix [3][3]float64
+
+func (m *matrix) apply(x, y, z float64) (a, b, c float64) {
+	a = m[0][0]*x + m[0][1]*y + m[0][2]*z
+	b = m[1][0]*x + m[1][1]*y + m[1][2]*z
+	c = m[2][0]*x + m[2][1]*y + m[2][2]*z
+	return
+}
+
+// the conversions of CSS Color 4, between linear sRGB, XYZ (D65) and XYZ (D50)
+var (
+	linearToXYZ = matrix{
+		{506752.0 / 1228815, 87881.0 / 245763, 12673.0 / 70218},
+		{87098.0 / 409605, 175762.0 / 245763, 12673.0 / 175545},
+		{7918.0 / 409605, 87881.0 / 737289, 1001167.0 / 1053270},
+	}
+	xyzToLinear = matrix{
+		{12831.0 / 3959, -329.0 / 214, -1974.0 / 3959},
+		{-851781.0 / 878810, 1648619.0 / 878810, 36519.0 / 878810},
+		{705.0 / 12673, -2585.0 / 12673, 705.0 / 667},
+	}
+	d65ToD50 = matrix{
+		{1.0479297925449969, 0.022946870601609652, -0.05019226628920524},
+		{0.02962780877005599, 0.9904344267538799, -0.017073799063418826},
+		{-0.009243040646204504, 0.015055191490298152, 0.7518742814281371},
+	}
+	d50ToD65 = matrix{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+	// the D50 white point, of Lab
+	d50White = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+)
+
+// returns the color of the specified CIE XYZ components, relative to D65
+func FromXYZ(x, y, z, alpha float64) (c Color) {
+	c.R, c.G, c.B = xyzToLinear.apply(x, y, z)
+	c.Alpha = alpha
+	return
+}
+
+// returns the CIE XYZ components of the color, relative to D65
+func (c Color) XYZ() (x, y, z float64) {
+	x, y, z = linearToXYZ.apply(c.R, c.G, c.B)
+	return
+}
+
+// the constants of Lab: ε and κ
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+// returns the color of the specified CIE Lab components, relative to D50 (as in CSS)
+func FromLab(l, a, b, alpha float64) (c Color) {
+	f1 := (l + 16) / 116
+	f0, f2 := a/500+f1, f1-b/200
+	var xyz [3]float64
+	if xyz[0] = f0 * f0 * f0; xyz[0] <= labEpsilon {
+		xyz[0] = (116*f0 - 16) / labKappa
+	}
+	if l > labKappa*labEpsilon {
+		xyz[1] = f1 * f1 * f1
+	} else {
+		xyz[1] = l / labKappa
+	}
+	if xyz[2] = f2 * f2 * f2; xyz[2] <= labEpsilon {
+		xyz[2] = (116*f2 - 16) / labKappa
+	}
+	for i := range xyz {
+		xyz[i] *= d50White[i]
+	}
+	x, y, z := d50ToD65.apply(xyz[0], xyz[1], xyz[2])
+	c = FromXYZ(x, y, z, alpha)
+	return
+}
+
+// returns the CIE Lab components of the color, relative to D50 (as in CSS)
+func (c Color) Lab() (l, a, b float64) {
+	var xyz, f [3]float64
+	xyz[0], xyz[1], xyz[2] = d65ToD50.apply(c.XYZ())
+	for i := range xyz {
+		if x := xyz[i] / d50White[i]; x > labEpsilon {
+			f[i] = math.Cbrt(x)
+		} else {
+			f[i] = (labKappa*x + 16) / 116
+		}
+	}
+	l, a, b = 116*f[1]-16, 500*(f[0]-f[1]), 200*(f[1]-f[2])
+	return
+}
+
+// the options of a single decoding
+type decodeOptions struct {
+	gamut     Gamut
+	precision int
+	trim      bool
+	nearest   bool
+}
+
+// returns the options of decoding
+func readOptions(opts0 []xl8r.Opts) (r decodeOptions, e error) {
+	r = decodeOptions{gamut: GamutClip, precision: defaultPrecision, trim: true}
+	if len(opts0) == 0 {
+		return
+	}
+	decoderOpts := opts0[0].Dec
+	if xValue, exists := decoderOpts[OptGamut]; exists {
+		gamut, ok := xValue.(Gamut)
+		if !ok || (gamut != GamutClip && gamut != GamutReject && gamut != GamutNone) {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptGamut, xValue)
+			return
+		}
+		r.gamut = gamut
+	}
+	if xValue, exists := decoderOpts[OptPrecision]; exists {
+		precision, ok := xValue.(int)
+		if !ok || precision < 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptPrecision, xValue)
+			return
+		}
+		r.precision, r.trim = precision, false
+	}
+	if xValue, exists := decoderOpts[OptNearest]; exists {
+		nearest, ok := xValue.(bool)
+		if !ok {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptNearest, xValue)
+			return
+		}
+		r.nearest = nearest
+	}
+	return
+}
+
+// returns an error, if any component of the specified color is not a finite number
+func validate(c Color) (e error) {
+	if sum := c.R + c.G + c.B + c.Alpha; math.IsNaN(sum) || math.IsInf(sum, 0) {
+		e = fmt.Errorf("invalid color [ %v ]", c)
+	}
+	return
+}
+
+// returns the gamma-encoded sRGB components of the specified color, as set by the gamut option
+//   - when clip is bool true, colors are clipped unless rejected (ie. GamutNone is ignored)
+func (o decodeOptions) srgb(c Color, clip bool) (r [3]float64, e error) {
+	if e = validate(c); e != nil {
+		return
+	}
+	if !c.InGamut() && o.gamut == GamutReject {
+		e = fmt.Errorf("color out of sRGB gamut [ %v ]", c)
+		return
+	}
+	r[0], r[1], r[2] = c.SRGB()
+	if clip || o.gamut != GamutNone {
+		for i := range r {
+			r[i] = math.Min(math.Max(r[i], 0), 1)
+		}
+	}
+	return
+}
+
+// formats the specified number, with the precision of decoding
+func (o decodeOptions) number(x float64) (r string) {
+	r = strconv.FormatFloat(x, 'f', o.precision, 64)
+	if o.trim && strings.Contains(r, ".") {
+		r = strings.TrimRight(strings.TrimRight(r, "0"), ".")
+	}
+	if strings.Trim(r, "-0.") == "" {
+		// no negative zero, after rounding
+		r = strings.TrimPrefix(r, "-")
+	}
+	return
+}
+
+// returns the alpha suffix of a functional notation (eg. " / 0.5"), or an empty string if opaque
+func (o decodeOptions) alpha(c Color) (r string) {
+	if alpha := o.number(math.Min(math.Max(c.Alpha, 0), 1)); alpha != o.number(1) {
+		r = " / " + alpha
+	}
+	return
+}
+
+// returns the codecs of this package: "hex", "rgb", "hsl", "hsv", "cmyk", "lab" and "named"
+func Codecs() (r []xl8r.Codec[string, Color]) {
+	r = []xl8r.Codec[string, Color]{Hex(), RGB(), HSL(), HSV(), CMYK(), Lab(), Named()}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, Color], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
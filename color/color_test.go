@@ -0,0 +1,133 @@
+package color
+
+import (
+	"math"
+	"testing"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestColor(t *testing.T) {
+	convertColor, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := func(kv ...any) xl8r.Opts {
+		dec := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			dec[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Dec: dec}
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		opts                     xl8r.Opts
+		expectedErr              bool
+	}{
+		{to: "hsl", from: "hex", text: "#ff8000", expected: "hsl(30.12 100% 50%)"},
+		{to: "rgb", from: "hex", text: "#ff8000", expected: "rgb(255 128 0)"},
+		{to: "rgb", from: "hex", text: "F80", expected: "rgb(255 136 0)"},
+		{to: "rgb", from: "hex", text: "#ff800080", expected: "rgb(255 128 0 / 0.5)"},
+		{to: "rgb", from: "hex", text: "#ff800080", opts: opts(OptPrecision, 3), expected: "rgb(255.000 128.000 0.000 / 0.502)"},
+		{to: "hex", from: "rgb", text: "rgba(255, 128, 0, 0.5)", expected: "#ff800080"},
+		{to: "hex", from: "rgb", text: "RGB(100% 50% 0% / 25%)", expected: "#ff800040"},
+		{to: "hex", from: "hsl", text: "hsl(0.5turn 100% 25%)", expected: "#008080"},
+		{to: "hex", from: "hsl", text: "hsla(240, 100%, 50%, 1)", expected: "#0000ff"},
+		{to: "hsv", from: "hex", text: "#ff8000", expected: "hsv(30.12 100% 100%)"},
+		{to: "hex", from: "hsv", text: "hsv(120 50% 50%)", expected: "#408040"},
+		{to: "cmyk", from: "hex", text: "#ff8000", opts: opts(OptPrecision, 0), expected: "cmyk(0% 50% 100% 0%)"},
+		{to: "hex", from: "cmyk", text: "device-cmyk(0 0.5 1 0)", expected: "#ff8000"},
+		{to: "cmyk", from: "named", text: "black", expected: "cmyk(0% 0% 0% 100%)"},
+		{to: "lab", from: "named", text: "red", expected: "lab(54.29 80.8 69.89)"},
+		{to: "lab", from: "named", text: "White", expected: "lab(100 0 0)"},
+		{to: "hex", from: "lab", text: "lab(75.59 27.52 79.12)", expected: "#ffa500"},
+		{to: "named", from: "lab", text: "lab(75.59 27.52 79.12)", expected: "orange"},
+		{to: "named", from: "hex", text: "#00ffff", expected: "aqua"},
+		{to: "named", from: "hex", text: "#ff8001", opts: opts(OptNearest, true), expected: "darkorange"},
+		{to: "named", from: "rgb", text: "rgb(0 0 0 / 0)", expected: "transparent"},
+		{to: "rgb", from: "named", text: "transparent", expected: "rgb(0 0 0 / 0)"},
+
+		// a color outside the sRGB gamut
+		{to: "rgb", from: "lab", text: "lab(50 120 0)", expected: "rgb(255 0 125.86)"},
+		{to: "rgb", from: "lab", text: "lab(50 120 0)", opts: opts(OptGamut, GamutNone, OptPrecision, 0), expected: "rgb(279 -105 126)"},
+		{to: "hex", from: "lab", text: "lab(50 120 0)", opts: opts(OptGamut, GamutNone), expected: "#ff007e"},
+		{to: "lab", from: "lab", text: "lab(50 120 0)", opts: opts(OptGamut, GamutReject), expected: "lab(50 120 0)"},
+		{to: "hsl", from: "lab", text: "lab(100 60 0)", opts: opts(OptGamut, GamutNone), expected: "hsl(300 100% 90.41%)"},
+		{to: "hsv", from: "lab", text: "lab(100 60 0)", opts: opts(OptGamut, GamutNone), expected: "hsv(300 19.18% 100%)"},
+		{to: "rgb", from: "lab", text: "lab(50 120 0)", opts: opts(OptGamut, GamutReject), expectedErr: true},
+
+		{to: "hex", from: "hex", text: "#ff80", expected: "#ffff8800"},
+		{to: "hex", from: "hex", text: "#ff8", expected: "#ffff88"},
+		{to: "hex", from: "hex", text: "#ff800", expectedErr: true},
+		{to: "hex", from: "hex", text: "#gg8000", expectedErr: true},
+		{to: "hex", from: "rgb", text: "rgb(255 128)", expectedErr: true},
+		{to: "hex", from: "rgb", text: "rgb(255 128 0 / )", expectedErr: true},
+		{to: "hex", from: "rgb", text: "rgb(255deg 128 0)", expectedErr: true},
+		{to: "hex", from: "hsl", text: "hsl(30 -50% 50%)", expected: "#808080"},
+		{to: "hex", from: "hsl", text: "hsl(30 150% 50%)", expected: "#ff8000"},
+		{to: "hex", from: "hsv", text: "hsv(30 -50% 50%)", expected: "#808080"},
+		{to: "hex", from: "hsl", text: "hsl(30% 100% 50%)", expectedErr: true},
+		{to: "hex", from: "rgb", text: "rgb(1e999 0 0)", expectedErr: true},
+		{to: "hex", from: "rgb", text: "rgb(1e300 0 0)", expectedErr: true},
+		{to: "hex", from: "named", text: "orangey", expectedErr: true},
+		{to: "named", from: "hex", text: "#ff8001", expectedErr: true},
+		{to: "named", from: "hex", text: "#ffa50080", expectedErr: true},
+		{to: "hex", from: "hex", text: "#ff8000", opts: opts(OptGamut, "squeeze"), expectedErr: true},
+		{to: "hex", from: "hex", text: "#ff8000", opts: opts(OptNearest, "yes"), expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertColor.To(tx.to, tx.from, tx.text, tx.opts)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s",%v) ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, tx.opts.Dec, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestColorSpaces(t *testing.T) {
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) < 1e-9
+	}
+	c := FromSRGB(1, 0.5, 0, 0.25)
+	if r, g, b := c.SRGB(); !near(r, 1) || !near(g, 0.5) || !near(b, 0) || c.Alpha != 0.25 {
+		t.Errorf("SRGB() ==>> %v %v %v", r, g, b)
+	}
+	if x, y, z := FromSRGB(1, 1, 1, 1).XYZ(); !near(x, 0.3127/0.3290) || !near(y, 1) || !near(z, (1-0.3127-0.3290)/0.3290) {
+		t.Errorf("XYZ() of white ==>> %v %v %v", x, y, z)
+	}
+	l, a, b := c.Lab()
+	if back := FromLab(l, a, b, c.Alpha); !near(back.R, c.R) || !near(back.G, c.G) || !near(back.B, c.B) {
+		t.Errorf("FromLab(%v, %v, %v) ==>> %v, expected %v", l, a, b, back, c)
+	}
+	if FromLab(50, 120, 0, 1).InGamut() || !c.InGamut() {
+		t.Errorf("unexpected InGamut()")
+	}
+
+	evaluated := map[string]string{
+		"#ff8000": "hex", "rgb(255 128 0)": "rgb", "hsl(30 100% 50%)": "hsl", "hsv(30 100% 100%)": "hsv",
+		"cmyk(0% 50% 100% 0%)": "cmyk", "lab(75.59 27.52 79.12)": "lab", "orange": "named",
+	}
+	for text, expected := range evaluated {
+		for _, codec := range Codecs() {
+			if codec.Evaluate(text) != (codec.Name() == expected) {
+				t.Errorf(`%s.Evaluate("%s") ==>> %v`, codec.Name(), text, !(codec.Name() == expected))
+			}
+		}
+	}
+	for _, text := range []string{"ff8000", " #ff8000", "Orange", "rgb(255 128 0) ", "rgb(1e999 0 0)", "lab(1e999 0 0)"} {
+		for _, codec := range Codecs() {
+			if codec.Evaluate(text) {
+				t.Errorf(`%s.Evaluate("%s") ==>> true`, codec.Name(), text)
+			}
+		}
+	}
+
+	if len(namedColors) != 148+1 {
+		t.Errorf("expected 148 named colors and transparent, but was %d", len(namedColors))
+	}
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+}
@@ -0,0 +1,451 @@
+package color
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// an argument of a functional notation (eg. "50%" or "120deg")
+type argument struct {
+	value float64
+	unit  string
+}
+
+// a functional notation (eg. "rgb(255 128 0)")
+var functionPattern = regexp.MustCompile(`^([a-zA-Z-]+)\(\s*(.*?)\s*\)$`)
+
+// an argument of a functional notation (eg. "50%", "-1.5e2" or ".5turn")
+var argumentPattern = regexp.MustCompile(`^([+-]?(?:\d+\.?\d*|\.\d+)(?:[eE][+-]?\d+)?)(%|deg|rad|grad|turn)?$`)
+
+func parseArgument(s string) (r argument, ok bool) {
+	matches := argumentPattern.FindStringSubmatch(strings.ToLower(s))
+	if matches == nil {
+		return
+	}
+	value, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil || math.IsInf(value, 0) {
+		return
+	}
+	r.value, r.unit, ok = value, matches[2], true
+	return
+}
+
+// returns the value of the specified argument, being either a number or a percentage
+// (of which 100% is the specified scale)
+func (a argument) number(scale float64) (r float64, ok bool) {
+	switch a.unit {
+	case "":
+		r, ok = a.value, true
+	case "%":
+		r, ok = a.value/100*scale, true
+	}
+	return
+}
+
+// returns the value of the specified argument, being a hue in degrees, or an angle
+func (a argument) hue() (r float64, ok bool) {
+	ok = true
+	switch a.unit {
+	case "", "deg":
+		r = a.value
+	case "rad":
+		r = a.value * 180 / math.Pi
+	case "grad":
+		r = a.value * 0.9
+	case "turn":
+		r = a.value * 360
+	default:
+		ok = false
+	}
+	return
+}
+
+// reads the specified functional notation, of any of the specified names (eg. "rgb" or "rgba"),
+// with n arguments and an optional alpha
+//   - the arguments are separated by spaces, and the alpha by a slash (eg. "rgb(255 128 0 / 50%)"),
+//     or all are separated by commas (eg. "rgba(255, 128, 0, 0.5)")
+func parseFunction(v string, n int, names ...string) (r []argument, alpha float64, e error) {
+	alpha = 1
+	matches := functionPattern.FindStringSubmatch(v)
+	valid := false
+	for _, name := range names {
+		valid = valid || (matches != nil && strings.EqualFold(matches[1], name))
+	}
+	if !valid {
+		e = fmt.Errorf("invalid %s color [ %s ]", names[0], v)
+		return
+	}
+
+	var fields []string
+	alphaField := ""
+	if body := matches[2]; strings.Contains(body, ",") {
+		for _, field := range strings.Split(body, ",") {
+			fields = append(fields, strings.TrimSpace(field))
+		}
+		if len(fields) == n+1 {
+			fields, alphaField = fields[:n], fields[n]
+		}
+	} else {
+		before, after, found := strings.Cut(body, "/")
+		if fields = strings.Fields(before); found {
+			if alphaFields := strings.Fields(after); len(alphaFields) == 1 {
+				alphaField = alphaFields[0]
+			} else {
+				fields = nil
+			}
+		}
+	}
+	if len(fields) != n {
+		e = fmt.Errorf("invalid %s color [ %s ]", names[0], v)
+		return
+	}
+	for _, field := range fields {
+		a, ok := parseArgument(field)
+		if !ok {
+			e = fmt.Errorf("invalid %s color [ %s ]", names[0], v)
+			return
+		}
+		r = append(r, a)
+	}
+	if len(alphaField) > 0 {
+		a, ok := parseArgument(alphaField)
+		if ok {
+			alpha, ok = a.number(1)
+		}
+		if !ok {
+			r, e = nil, fmt.Errorf("invalid %s color [ %s ]", names[0], v)
+			return
+		}
+		alpha = math.Min(math.Max(alpha, 0), 1)
+	}
+	return
+}
+
+// a codec of a functional notation
+type function struct {
+	id string
+	// the names of the function (eg. "rgb" and "rgba")
+	names []string
+	// the number of arguments, before the alpha
+	n int
+	// returns the color of the specified arguments
+	read func(args []argument, alpha float64) (r Color, ok bool)
+	// returns the arguments of the specified color
+	write func(c Color, o decodeOptions) (r []string, e error)
+}
+
+func (f *function) parse(v string) (r Color, e error) {
+	var args []argument
+	var alpha float64
+	if args, alpha, e = parseFunction(v, f.n, f.names...); e != nil {
+		return
+	}
+	var ok bool
+	if r, ok = f.read(args, alpha); !ok || validate(r) != nil {
+		r, e = Color{}, fmt.Errorf("invalid %s color [ %s ]", f.id, v)
+	}
+	return
+}
+
+func (f *function) codec() (r *xl8r.Spoke[string, Color]) {
+	r = &xl8r.Spoke[string, Color]{
+		Id: f.id,
+		Enc: func(v string, _ ...xl8r.Opts) (Color, error) {
+			return f.parse(strings.TrimSpace(v))
+		},
+		Dec: func(v Color, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(opts0); e != nil {
+				return
+			}
+			var args []string
+			if args, e = f.write(v, o); e != nil {
+				return
+			}
+			r = f.names[0] + "(" + strings.Join(args, " ") + o.alpha(v) + ")"
+			return
+		},
+		Check: func(v string) (r bool) {
+			_, err := f.parse(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns the values of the specified arguments, each being a number or a percentage (of the specified scale)
+func numbers(args []argument, scales ...float64) (r []float64, ok bool) {
+	r = make([]float64, len(args))
+	for i, a := range args {
+		if r[i], ok = a.number(scales[i]); !ok {
+			return
+		}
+	}
+	return
+}
+
+// returns the codec for the CSS rgb() notation (eg. "rgb(255 128 0)" or "rgba(100%, 50%, 0%, 0.5)")
+func RGB() (r *xl8r.Spoke[string, Color]) {
+	r = (&function{
+		id:    "rgb",
+		names: []string{"rgb", "rgba"},
+		n:     3,
+		read: func(args []argument, alpha float64) (r Color, ok bool) {
+			var x []float64
+			if x, ok = numbers(args, 255, 255, 255); ok {
+				r = FromSRGB(x[0]/255, x[1]/255, x[2]/255, alpha)
+			}
+			return
+		},
+		write: func(c Color, o decodeOptions) (r []string, e error) {
+			var s [3]float64
+			if s, e = o.srgb(c, false); e == nil {
+				r = []string{o.number(s[0] * 255), o.number(s[1] * 255), o.number(s[2] * 255)}
+			}
+			return
+		},
+	}).codec()
+	return
+}
+
+// returns the hue (in degrees), the maximum and the minimum of the specified sRGB components
+func hueOf(s [3]float64) (h, max, min float64) {
+	max, min = math.Max(s[0], math.Max(s[1], s[2])), math.Min(s[0], math.Min(s[1], s[2]))
+	d := max - min
+	switch {
+	case d == 0:
+	case max == s[0]:
+		h = math.Mod((s[1]-s[2])/d+6, 6)
+	case max == s[1]:
+		h = (s[2]-s[0])/d + 2
+	default:
+		h = (s[0]-s[1])/d + 4
+	}
+	h *= 60
+	return
+}
+
+// returns the hue of the specified argument, in [0, 360)
+func hueArgument(a argument) (r float64, ok bool) {
+	if r, ok = a.hue(); ok {
+		if r = math.Mod(r, 360); r < 0 {
+			r += 360
+		}
+	}
+	return
+}
+
+// returns the codec for the CSS hsl() notation (eg. "hsl(30 100% 50%)" or "hsla(30, 100%, 50%, 0.5)")
+func HSL() (r *xl8r.Spoke[string, Color]) {
+	r = (&function{
+		id:    "hsl",
+		names: []string{"hsl", "hsla"},
+		n:     3,
+		read: func(args []argument, alpha float64) (r Color, ok bool) {
+			var h float64
+			var x []float64
+			if h, ok = hueArgument(args[0]); !ok {
+				return
+			}
+			if x, ok = numbers(args[1:], 100, 100); !ok {
+				return
+			}
+			// a saturation out of [0%, 100%] would invert or exaggerate the hue
+			s, l := math.Min(math.Max(x[0], 0), 100)/100, x[1]/100
+			var rgb [3]float64
+			for i, n := range []float64{0, 8, 4} {
+				k := math.Mod(n+h/30, 12)
+				rgb[i] = l - s*math.Min(l, 1-l)*math.Max(-1, math.Min(math.Min(k-3, 9-k), 1))
+			}
+			r = FromSRGB(rgb[0], rgb[1], rgb[2], alpha)
+			return
+		},
+		write: func(c Color, o decodeOptions) (r []string, e error) {
+			var s [3]float64
+			if s, e = o.srgb(c, true); e != nil {
+				return
+			}
+			h, max, min := hueOf(s)
+			l, saturation := (max+min)/2, 0.0
+			if d, denominator := max-min, 1-math.Abs(2*l-1); d != 0 && denominator > 0 {
+				saturation = math.Min(d/denominator, 1)
+			}
+			r = []string{o.number(h), o.number(saturation*100) + "%", o.number(l*100) + "%"}
+			return
+		},
+	}).codec()
+	return
+}
+
+// returns the codec for the hsv() notation (eg. "hsv(30 100% 100%)"), following the syntax of hsl()
+func HSV() (r *xl8r.Spoke[string, Color]) {
+	r = (&function{
+		id:    "hsv",
+		names: []string{"hsv", "hsva"},
+		n:     3,
+		read: func(args []argument, alpha float64) (r Color, ok bool) {
+			var h float64
+			var x []float64
+			if h, ok = hueArgument(args[0]); !ok {
+				return
+			}
+			if x, ok = numbers(args[1:], 100, 100); !ok {
+				return
+			}
+			s, v := math.Min(math.Max(x[0], 0), 100)/100, x[1]/100
+			var rgb [3]float64
+			for i, n := range []float64{5, 3, 1} {
+				k := math.Mod(n+h/60, 6)
+				rgb[i] = v - v*s*math.Max(0, math.Min(math.Min(k, 4-k), 1))
+			}
+			r = FromSRGB(rgb[0], rgb[1], rgb[2], alpha)
+			return
+		},
+		write: func(c Color, o decodeOptions) (r []string, e error) {
+			var s [3]float64
+			if s, e = o.srgb(c, true); e != nil {
+				return
+			}
+			h, max, min := hueOf(s)
+			saturation := 0.0
+			if max != 0 {
+				saturation = (max - min) / max
+			}
+			r = []string{o.number(h), o.number(saturation*100) + "%", o.number(max*100) + "%"}
+			return
+		},
+	}).codec()
+	return
+}
+
+// returns the codec for the cmyk() notation (eg. "cmyk(0% 50% 100% 0%)"), of naive (ie. device) CMYK
+//   - encoding also accepts the device-cmyk() notation of CSS, and numbers from 0 to 1 (eg. "cmyk(0 0.5 1 0)")
+func CMYK() (r *xl8r.Spoke[string, Color]) {
+	r = (&function{
+		id:    "cmyk",
+		names: []string{"cmyk", "device-cmyk"},
+		n:     4,
+		read: func(args []argument, alpha float64) (r Color, ok bool) {
+			var x []float64
+			if x, ok = numbers(args, 1, 1, 1, 1); ok {
+				k := 1 - x[3]
+				r = FromSRGB((1-x[0])*k, (1-x[1])*k, (1-x[2])*k, alpha)
+			}
+			return
+		},
+		write: func(c Color, o decodeOptions) (r []string, e error) {
+			var s [3]float64
+			if s, e = o.srgb(c, true); e != nil {
+				return
+			}
+			k := 1 - math.Max(s[0], math.Max(s[1], s[2]))
+			var cmy [3]float64
+			if k < 1 {
+				for i := range cmy {
+					cmy[i] = (1 - s[i] - k) / (1 - k)
+				}
+			}
+			for _, x := range append(cmy[:], k) {
+				r = append(r, o.number(x*100)+"%")
+			}
+			return
+		},
+	}).codec()
+	return
+}
+
+// returns the codec for the CSS lab() notation (eg. "lab(75.59 27.52 79.12)"), of CIE Lab relative to D50
+//   - colors are written as they are, whatever the gamut option
+func Lab() (r *xl8r.Spoke[string, Color]) {
+	r = (&function{
+		id:    "lab",
+		names: []string{"lab"},
+		n:     3,
+		read: func(args []argument, alpha float64) (r Color, ok bool) {
+			var x []float64
+			if x, ok = numbers(args, 100, 125, 125); ok {
+				r = FromLab(x[0], x[1], x[2], alpha)
+			}
+			return
+		},
+		write: func(c Color, o decodeOptions) (r []string, e error) {
+			if e = validate(c); e != nil {
+				return
+			}
+			l, a, b := c.Lab()
+			r = []string{o.number(l), o.number(a), o.number(b)}
+			return
+		},
+	}).codec()
+	return
+}
+
+// returns the 8-bit value of the specified component, in [0, 1]
+func toByte(x float64) (r int) {
+	r = int(math.Round(math.Min(math.Max(x, 0), 1) * 255))
+	return
+}
+
+// reads the specified hexadecimal notation, without "#" (eg. "ff8000", "f80" or "ff800080")
+func parseHex(v string) (r Color, e error) {
+	digits := v
+	if len(v) == 3 || len(v) == 4 {
+		digits = ""
+		for _, d := range v {
+			digits += string([]rune{d, d})
+		}
+	}
+	if len(digits) == 6 {
+		digits += "ff"
+	}
+	value, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil || len(digits) != 8 {
+		e = fmt.Errorf("invalid hex color [ %s ]", v)
+		return
+	}
+	byteAt := func(i uint) float64 {
+		return float64(value>>(24-8*i)&0xff) / 255
+	}
+	r = FromSRGB(byteAt(0), byteAt(1), byteAt(2), byteAt(3))
+	return
+}
+
+// returns the codec for the CSS hexadecimal notation (eg. "#ff8000", "#f80" or "#ff800080")
+//   - encoding also accepts colors without "#" (eg. "FF8000")
+func Hex() (r *xl8r.Spoke[string, Color]) {
+	r = &xl8r.Spoke[string, Color]{
+		Id: "hex",
+		Enc: func(v string, _ ...xl8r.Opts) (Color, error) {
+			return parseHex(strings.TrimPrefix(strings.TrimSpace(v), "#"))
+		},
+		Dec: func(v Color, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(opts0); e != nil {
+				return
+			}
+			var s [3]float64
+			if s, e = o.srgb(v, true); e != nil {
+				return
+			}
+			r = fmt.Sprintf("#%02x%02x%02x", toByte(s[0]), toByte(s[1]), toByte(s[2]))
+			if alpha := toByte(v.Alpha); alpha != 0xff {
+				r += fmt.Sprintf("%02x", alpha)
+			}
+			return
+		},
+		Check: func(v string) (r bool) {
+			if hex, found := strings.CutPrefix(v, "#"); found {
+				_, err := parseHex(hex)
+				r = err == nil
+			}
+			return
+		},
+	}
+	return
+}
@@ -0,0 +1,33 @@
+package xl8r
+
+import "log/slog"
+
+// settings that alter the behavior of an Interpreter
+//   - the zero value is valid, and matches the behavior of New(..)
+type Config struct {
+	// optional logger for translations
+	//   - failed translations are logged at level WARN
+	//   - each translation is logged at level DEBUG, when that level is enabled
+	Logger *slog.Logger
+	// when bool true, content, hub data and results are included in log records
+	//   - by default, these values are redacted
+	LogContent bool
+	// when bool true, panics raised by codecs are recovered
+	//   - Encode and Decode panics are returned as a *CodecPanicError
+	//   - Evaluate panics are treated as bool false
+	SafeMode bool
+	// optional observer, called with each panic recovered in SafeMode
+	//   - also called with each panic raised after a call timed out (see Policy), in any mode
+	OnPanic func(e *CodecPanicError)
+	// optional limits, for the calls made to the named codecs
+	//   - a policy named after an unversioned codec (eg. "kana") applies to all of its versions,
+	//     which share a single concurrency limit and circuit breaker
+	//   - a policy named after a version (eg. "kana@2") applies to that version only
+	Policies map[string]Policy
+	// optional source of time, for retry backoff and circuit breakers
+	//   - nil means the system clock
+	Clock Clock
+	// when bool true, Origins(..) reports every matching version of a codec
+	//   - by default, only the latest matching version is reported
+	AllVersions bool
+}
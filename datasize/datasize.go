@@ -0,0 +1,407 @@
+/*
+Package datasize provides codecs for data sizes (eg. "1.5GB", "1536MiB" or "1073741824"),
+with *big.Int hub data (a number of bytes).
+
+	convertSize, err := datasize.New(xl8r.Config{})
+	size, err := convertSize.To("si", "iec", "1536MiB")	// "1.61 GB"
+
+Encoding is lenient: units are matched case-insensitively (eg. "1.5gb"), Kubernetes-style
+shorthands are accepted (eg. "1.5G" or "512Mi", though not "100m", which means milli), digits may be separated
+(eg. "1_000 MB" or "1,000 MB", though not with a decimal comma, as in "1,5 GB") and sizes are rounded to the nearest byte.
+Bit units are never read as byte units, nor the other way round (eg. "1 Mb" is rejected by "si", and "1 MB" by "bits").
+Evaluation (ie. Check) is strict, and accepts only whole numbers of bytes, written with exact unit symbols (eg. "1.5 GB" or "1.5GB").
+
+Decoding chooses the largest unit, of which there is at least one (eg. "1.5 GB" rather than "1500 MB"),
+and may be tuned with these xl8r.Opts Dec keys:
+  - OptUnit (string): the unit of the decoded size (eg. "MiB")
+  - OptPrecision (int): the number of decimal places (by default, as many as needed, up to 2)
+
+The codecs are also registered under the "datasize" domain (see xl8r.NewFromDomain).
+*/
+package datasize
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "datasize"
+
+// the xl8r.Opts Dec keys, read by the decoders of this package
+const (
+	OptUnit      = "unit"
+	OptPrecision = "precision"
+)
+
+// the number of decimal places used, when no precision is specified
+// (trailing zeros are dropped)
+const defaultPrecision = 2
+
+// a unit of data size
+type sizeUnit struct {
+	// the symbol of the unit (eg. "MiB")
+	symbol string
+	// other names of the unit, accepted in any case when encoding leniently (eg. "Mi", "mebibytes")
+	aliases []string
+	// other names of the unit, accepted as they are when encoding leniently,
+	// as they mean other units in another case (eg. "Mb", but not "MB")
+	shorthands []string
+	// the size of the unit, in bits
+	bits *big.Int
+	// when bool true, the unit is not chosen automatically by decoding
+	manual bool
+}
+
+// a family of units
+type family struct {
+	id string
+	// the units, from the smallest
+	units []*sizeUnit
+	// when bool true, a number without unit is a number of bytes
+	bare bool
+	// the units, by symbol
+	exact map[string]*sizeUnit
+	// the units, by shorthand
+	shorthand map[string]*sizeUnit
+	// the units, by lower-case symbol or alias
+	folded map[string]*sizeUnit
+	// names of units of another family, which are rejected before case is folded (eg. "Mb" as "MB")
+	rejected map[string]bool
+}
+
+// returns the unit of the specified size in bits, raised to the specified power
+func power(bits, base int64, exponent int) (r *big.Int) {
+	r = new(big.Int).Exp(big.NewInt(base), big.NewInt(int64(exponent)), nil)
+	r.Mul(r, big.NewInt(bits))
+	return
+}
+
+func newFamily(id string, bare bool, rejected []string, units ...*sizeUnit) (r *family) {
+	r = &family{
+		id:        id,
+		units:     units,
+		bare:      bare,
+		exact:     make(map[string]*sizeUnit),
+		shorthand: make(map[string]*sizeUnit),
+		folded:    make(map[string]*sizeUnit),
+		rejected:  make(map[string]bool),
+	}
+	for _, name := range rejected {
+		r.rejected[name] = true
+	}
+	for _, u := range units {
+		r.exact[u.symbol] = u
+		for _, name := range u.shorthands {
+			r.shorthand[name] = u
+		}
+		for _, name := range append([]string{u.symbol}, u.aliases...) {
+			folded := strings.ToLower(name)
+			if other, exists := r.folded[folded]; exists && other != u {
+				panic(fmt.Sprintf("datasize: ambiguous unit '%s'", name))
+			}
+			r.folded[folded] = u
+		}
+	}
+	return
+}
+
+// a strictly written size (eg. "1.5 GB" or "1.5GB")
+var strictPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?) ?(\D*)$`)
+
+// a leniently written size (eg. " 1_000.5 gb")
+var lenientPattern = regexp.MustCompile(`^\s*(\d[\d_,]*(?:\.\d*)?|\.\d+)\s*(\D*?)\s*$`)
+
+// rounds the specified non-negative number of bits, to the nearest number of bytes
+func toBytes(bits *big.Rat) (r *big.Int) {
+	r = new(big.Int).Mul(bits.Num(), big.NewInt(2))
+	r.Add(r, new(big.Int).Mul(bits.Denom(), big.NewInt(8)))
+	r.Quo(r, new(big.Int).Mul(bits.Denom(), big.NewInt(16)))
+	return
+}
+
+// returns bool true, if each comma of the specified number separates thousands (eg. "1,500"),
+// so that a decimal comma (eg. "1,5") is not silently dropped
+func thousandsSeparated(number string) (r bool) {
+	for i := strings.IndexByte(number, ','); i >= 0; i = strings.IndexByte(number, ',') {
+		digits := strings.IndexFunc(number[i+1:]+".", func(c rune) bool { return c < '0' || c > '9' })
+		if digits != 3 {
+			return
+		}
+		number = number[i+1:]
+	}
+	r = true
+	return
+}
+
+// reads the specified size
+//   - when strict, the size must be a whole number of bytes, written with an exact unit symbol
+func (f *family) parse(v string, strict bool) (r *big.Int, e error) {
+	pattern := lenientPattern
+	if strict {
+		pattern = strictPattern
+	}
+	matches := pattern.FindStringSubmatch(v)
+	if matches == nil {
+		e = fmt.Errorf("invalid data size [ %s ]", v)
+		return
+	}
+	number, name := matches[1], matches[2]
+	if !strict {
+		if !thousandsSeparated(number) {
+			e = fmt.Errorf("invalid data size [ %s ]", v)
+			return
+		}
+		number = strings.NewReplacer("_", "", ",", "").Replace(number)
+	}
+
+	var u *sizeUnit
+	var exists bool
+	switch {
+	case len(name) == 0 && f.bare:
+		u, exists = f.units[0], true
+	case strict:
+		u, exists = f.exact[name]
+	default:
+		u, exists = f.lookup(name)
+	}
+	if !exists {
+		e = fmt.Errorf("unknown unit of %s [ '%s' ]", f.id, name)
+		return
+	}
+
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		e = fmt.Errorf("invalid data size [ %s ]", v)
+		return
+	}
+	bits := value.Mul(value, new(big.Rat).SetInt(u.bits))
+	if strict && !new(big.Rat).Quo(bits, big.NewRat(8, 1)).IsInt() {
+		e = fmt.Errorf("not a whole number of bytes [ %s ]", v)
+		return
+	}
+	r = toBytes(bits)
+	return
+}
+
+// returns the unit with the specified symbol, shorthand or (case-insensitive) alias
+func (f *family) lookup(name string) (r *sizeUnit, b bool) {
+	if f.rejected[name] {
+		return
+	}
+	if r, b = f.exact[name]; !b {
+		if r, b = f.shorthand[name]; !b {
+			r, b = f.folded[strings.ToLower(name)]
+		}
+	}
+	return
+}
+
+// the options of a single decoding
+type decodeOptions struct {
+	unit      string
+	precision int
+	trim      bool
+}
+
+// returns the options of decoding the specified hub data
+func readOptions(v *big.Int, opts0 []xl8r.Opts) (r decodeOptions, e error) {
+	r = decodeOptions{precision: defaultPrecision, trim: true}
+	switch {
+	case v == nil:
+		e = fmt.Errorf("nil data size")
+	case v.Sign() < 0:
+		e = fmt.Errorf("negative data size [ %v ]", v)
+	}
+	if e != nil || len(opts0) == 0 {
+		return
+	}
+	decoderOpts := opts0[0].Dec
+	if xValue, exists := decoderOpts[OptUnit]; exists {
+		unit, ok := xValue.(string)
+		if !ok || len(unit) == 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptUnit, xValue)
+			return
+		}
+		r.unit = unit
+	}
+	if xValue, exists := decoderOpts[OptPrecision]; exists {
+		precision, ok := xValue.(int)
+		if !ok || precision < 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptPrecision, xValue)
+			return
+		}
+		r.precision, r.trim = precision, false
+	}
+	return
+}
+
+// writes the specified number of bytes
+func (f *family) format(v *big.Int, opts0 []xl8r.Opts) (r string, e error) {
+	var o decodeOptions
+	if o, e = readOptions(v, opts0); e != nil {
+		return
+	}
+	bits := new(big.Int).Lsh(v, 3)
+	u := f.units[0]
+	if len(o.unit) > 0 {
+		var exists bool
+		if u, exists = f.lookup(o.unit); !exists {
+			e = fmt.Errorf("unknown unit of %s [ '%s' ]", f.id, o.unit)
+			return
+		}
+	} else {
+		for _, candidate := range f.units {
+			if !candidate.manual && candidate.bits.Cmp(bits) <= 0 {
+				u = candidate
+			}
+		}
+		// a size that rounds up to the next unit is written in that unit (eg. "1 MB" rather than "1000 kB")
+		for _, candidate := range f.units {
+			if candidate.manual || candidate.bits.Cmp(u.bits) <= 0 {
+				continue
+			}
+			rounded, _ := new(big.Rat).SetString(new(big.Rat).SetFrac(bits, u.bits).FloatString(o.precision))
+			if rounded.Cmp(new(big.Rat).SetFrac(candidate.bits, u.bits)) < 0 {
+				break
+			}
+			u = candidate
+		}
+	}
+	if r = new(big.Rat).SetFrac(bits, u.bits).FloatString(o.precision); o.trim && strings.Contains(r, ".") {
+		r = strings.TrimRight(strings.TrimRight(r, "0"), ".")
+	}
+	if !f.bare || u != f.units[0] {
+		r += " " + u.symbol
+	}
+	return
+}
+
+func (f *family) codec() (r *xl8r.Spoke[string, *big.Int]) {
+	r = &xl8r.Spoke[string, *big.Int]{
+		Id: f.id,
+		Enc: func(v string, _ ...xl8r.Opts) (*big.Int, error) {
+			return f.parse(v, false)
+		},
+		Dec: func(v *big.Int, opts0 ...xl8r.Opts) (string, error) {
+			return f.format(v, opts0)
+		},
+		Check: func(v string) (r bool) {
+			_, err := f.parse(v, true)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// the byte unit, common to the SI and IEC families
+func byteUnit() (r *sizeUnit) {
+	r = &sizeUnit{symbol: "B", aliases: []string{"byte", "bytes"}, bits: big.NewInt(8)}
+	return
+}
+
+// returns the shorthands of the bit units (eg. "Mb"), which the byte codecs reject
+func bitShorthands() (r []string) {
+	r = []string{"b"}
+	for _, p := range siPrefixes {
+		r = append(r, p.symbol+"b")
+	}
+	return
+}
+
+// returns the codec for numbers of bytes (eg. "1536", or leniently "1,536 bytes")
+func Bytes() (r *xl8r.Spoke[string, *big.Int]) {
+	r = newFamily("bytes", true, bitShorthands(), byteUnit()).codec()
+	return
+}
+
+var siPrefixes = []struct{ symbol, name string }{
+	{"k", "kilo"}, {"M", "mega"}, {"G", "giga"}, {"T", "tera"}, {"P", "peta"}, {"E", "exa"}, {"Z", "zetta"}, {"Y", "yotta"},
+}
+
+var iecPrefixes = []struct{ symbol, name string }{
+	{"Ki", "kibi"}, {"Mi", "mebi"}, {"Gi", "gibi"}, {"Ti", "tebi"}, {"Pi", "pebi"}, {"Ei", "exbi"}, {"Zi", "zebi"}, {"Yi", "yobi"},
+}
+
+// returns the codec for sizes in SI units (eg. "1.5 GB"), of powers of 1000
+func SI() (r *xl8r.Spoke[string, *big.Int]) {
+	units := []*sizeUnit{byteUnit()}
+	for i, p := range siPrefixes {
+		u := &sizeUnit{
+			symbol:     p.symbol + "B",
+			aliases:    []string{p.name + "byte", p.name + "bytes"},
+			shorthands: []string{strings.ToUpper(p.symbol)},
+			bits:       power(8, 1000, i+1),
+		}
+		// "m" means milli, rather than mega (eg. Kubernetes "100m")
+		if lower := strings.ToLower(p.symbol); lower != "m" {
+			u.shorthands = append(u.shorthands, lower)
+		}
+		units = append(units, u)
+	}
+	r = newFamily("si", false, bitShorthands(), units...).codec()
+	return
+}
+
+// returns the codec for sizes in IEC units (eg. "1536 MiB"), of powers of 1024
+func IEC() (r *xl8r.Spoke[string, *big.Int]) {
+	units := []*sizeUnit{byteUnit()}
+	for i, p := range iecPrefixes {
+		units = append(units, &sizeUnit{
+			symbol:  p.symbol + "B",
+			aliases: []string{p.symbol, p.name + "byte", p.name + "bytes"},
+			bits:    power(8, 1024, i+1),
+		})
+	}
+	r = newFamily("iec", false, bitShorthands(), units...).codec()
+	return
+}
+
+// returns the codec for sizes in bits (eg. "100 Mbit"), of powers of 1000
+//   - IEC units (eg. "Mibit") are accepted, and written only when set by OptUnit
+//   - shorthands (eg. "Mb") are accepted in their exact case only, as byte units (eg. "MB") are rejected
+func Bits() (r *xl8r.Spoke[string, *big.Int]) {
+	shorthands := bitShorthands()
+	units := []*sizeUnit{{symbol: "bit", aliases: []string{"bits"}, shorthands: shorthands[:1], bits: big.NewInt(1)}}
+	for i, p := range siPrefixes {
+		units = append(units, &sizeUnit{
+			symbol:     p.symbol + "bit",
+			aliases:    []string{p.symbol + "bits", p.name + "bit", p.name + "bits"},
+			shorthands: shorthands[i+1 : i+2],
+			bits:       power(1, 1000, i+1),
+		})
+	}
+	for i, p := range iecPrefixes {
+		units = append(units, &sizeUnit{
+			symbol:  p.symbol + "bit",
+			aliases: []string{p.symbol + "bits", p.name + "bit", p.name + "bits"},
+			bits:    power(1, 1024, i+1),
+			manual:  true,
+		})
+	}
+	r = newFamily("bits", false, nil, units...).codec()
+	return
+}
+
+// returns the codecs of this package: "bytes", "si", "iec" and "bits"
+func Codecs() (r []xl8r.Codec[string, *big.Int]) {
+	r = []xl8r.Codec[string, *big.Int]{Bytes(), SI(), IEC(), Bits()}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, *big.Int], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
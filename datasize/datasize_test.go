@@ -0,0 +1,119 @@
+package datasize
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestDataSize(t *testing.T) {
+	convertSize, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := func(kv ...any) xl8r.Opts {
+		dec := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			dec[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Dec: dec}
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		opts                     xl8r.Opts
+		expectedErr              bool
+	}{
+		{to: "si", from: "iec", text: "1536MiB", expected: "1.61 GB"},
+		{to: "iec", from: "si", text: "1.5GB", expected: "1.4 GiB"},
+		{to: "bytes", from: "si", text: "1.5GB", expected: "1500000000"},
+		{to: "bytes", from: "iec", text: "1536 MiB", expected: "1610612736"},
+		{to: "iec", from: "bytes", text: "1610612736", expected: "1.5 GiB"},
+		{to: "si", from: "bytes", text: "999", expected: "999 B"},
+		{to: "si", from: "bytes", text: "0", expected: "0 B"},
+		{to: "si", from: "bytes", text: "1,536 bytes", expected: "1.54 kB"},
+		{to: "si", from: "iec", text: "512Mi", expected: "536.87 MB"},
+		{to: "si", from: "bytes", text: "999999", expected: "1 MB"},
+		{to: "si", from: "bytes", text: "999999999", expected: "1 GB"},
+		{to: "si", from: "bytes", text: "999999999", opts: opts(OptPrecision, 6), expected: "999.999999 MB"},
+		{to: "iec", from: "bytes", text: "1048575", expected: "1 MiB"},
+		{to: "iec", from: "bytes", text: "1023", expected: "1023 B"},
+		{to: "si", from: "bytes", text: "999999", opts: opts(OptUnit, "kB"), expected: "1000 kB"},
+		{to: "bytes", from: "si", text: "1,000,000 B", expected: "1000000"},
+		{to: "iec", from: "si", text: "1.5g", expected: "1.4 GiB"},
+		{to: "si", from: "si", text: "1_000 MB", expected: "1 GB"},
+		{to: "si", from: "si", text: "2 terabytes", expected: "2 TB"},
+		{to: "bits", from: "si", text: "12.5 MB", expected: "100 Mbit"},
+		{to: "si", from: "bits", text: "100 Mbit", expected: "12.5 MB"},
+		{to: "bytes", from: "bits", text: "12 bit", expected: "2"},
+		{to: "bits", from: "iec", text: "1 MiB", opts: opts(OptUnit, "Mibit"), expected: "8 Mibit"},
+		{to: "si", from: "iec", text: "1536MiB", opts: opts(OptUnit, "MB"), expected: "1610.61 MB"},
+		{to: "si", from: "iec", text: "1536MiB", opts: opts(OptPrecision, 4), expected: "1.6106 GB"},
+		{to: "iec", from: "iec", text: "1 GiB", opts: opts(OptPrecision, 1), expected: "1.0 GiB"},
+		{to: "iec", from: "iec", text: "1 GiB", opts: opts(OptUnit, "kib"), expected: "1048576 KiB"},
+		{to: "si", from: "si", text: "100M", expected: "100 MB"},
+		{to: "si", from: "si", text: "100k", expected: "100 kB"},
+		{to: "bits", from: "bits", text: "1 Mb", expected: "1 Mbit"},
+		{to: "bits", from: "bits", text: "1 mbit", expected: "1 Mbit"},
+		{to: "si", from: "bits", text: "1 MB", expectedErr: true}, // a byte unit, not a megabit
+		{to: "si", from: "bits", text: "1 mb", expectedErr: true},
+		{to: "si", from: "si", text: "1 Mb", expectedErr: true}, // a bit unit, not a megabyte
+		{to: "si", from: "si", text: "1 kb", expectedErr: true},
+		{to: "si", from: "bytes", text: "8 b", expectedErr: true},
+		{to: "si", from: "si", text: "100m", expectedErr: true}, // milli, not mega
+		{to: "bits", from: "si", text: "1 MB", opts: opts(OptUnit, "MB"), expectedErr: true},
+		{to: "si", from: "si", text: "-1 GB", expectedErr: true},
+		{to: "si", from: "si", text: "1.5 GiB", expectedErr: true},
+		{to: "si", from: "si", text: "GB", expectedErr: true},
+		{to: "si", from: "si", text: "1,5 GB", expectedErr: true}, // a decimal comma, not 15 GB
+		{to: "si", from: "si", text: "1,5000 GB", expectedErr: true},
+		{to: "si", from: "si", text: "1 GB", opts: opts(OptUnit, "GiB"), expectedErr: true},
+		{to: "si", from: "si", text: "1 GB", opts: opts(OptPrecision, -1), expectedErr: true},
+		{to: "si", from: "si", text: "1 GB", opts: opts(OptUnit, 1), expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertSize.To(tx.to, tx.from, tx.text, tx.opts)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s",%v) ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, tx.opts.Dec, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tt := []struct {
+		codec    xl8r.Codec[string, *big.Int]
+		text     string
+		expected bool
+	}{
+		{Bytes(), "1536", true},
+		{Bytes(), "1536 B", true},
+		{Bytes(), "1,536", false},
+		{Bytes(), " 1536", false},
+		{SI(), "1.5 GB", true},
+		{SI(), "1.5GB", true},
+		{SI(), "1.5 gb", false},
+		{SI(), "1.5G", false},
+		{SI(), "1.5  GB", false},
+		{SI(), "1.5 GiB", false},
+		{SI(), "0.0001 kB", false},
+		{IEC(), "1536MiB", true},
+		{IEC(), "1536 Mi", false},
+		{IEC(), "1536 MB", false},
+		{Bits(), "100 Mbit", true},
+		{Bits(), "12 bit", false},
+		{Bits(), "16 bit", true},
+		{Bits(), "100 Mb", false},
+	}
+	for i, tx := range tt {
+		if result := tx.codec.Evaluate(tx.text); result != tx.expected {
+			t.Errorf(`# %d: %s.Evaluate("%s") ==>> %v, expected %v`, i, tx.codec.Name(), tx.text, result, tx.expected)
+		}
+	}
+
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Package duration provides codecs for durations written in various formats,
+with *big.Rat hub data (a number of seconds), so that no precision is lost between formats.
+
+	convertDuration, err := duration.New(xl8r.Config{})
+	iso, err := convertDuration.To("iso8601", "go", "1h30m0.5s")	// "PT1H30M0.5S"
+
+Decoding may be tuned with these xl8r.Opts Dec keys:
+  - OptPrecision (int): the number of decimal places of the smallest unit
+    (by default, as many as needed, up to 9)
+  - OptRounding (Rounding): how values are rounded to the precision (by default, RoundNearest)
+  - OptUnits ([]string): the units used by the "units" codec (eg. []string{"h", "m"})
+
+The codecs are also registered under the "duration" domain (see xl8r.NewFromDomain).
+*/
+package duration
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "duration"
+
+// the xl8r.Opts Dec keys, read by the decoders of this package
+const (
+	OptPrecision = "precision"
+	OptRounding  = "rounding"
+	OptUnits     = "units"
+)
+
+// how a value is rounded, to a given number of decimal places
+type Rounding string
+
+const (
+	// rounds to the nearest value, and halfway values away from zero
+	RoundNearest Rounding = "nearest"
+	// rounds towards zero (ie. truncates)
+	RoundDown Rounding = "down"
+	// rounds away from zero
+	RoundUp Rounding = "up"
+)
+
+// the number of decimal places used, when no precision is specified
+// (trailing zeros are dropped)
+const defaultPrecision = 9
+
+// returns the number of seconds of the specified time.Duration
+func Seconds(d time.Duration) (r *big.Rat) {
+	r = big.NewRat(int64(d), int64(time.Second))
+	return
+}
+
+// returns the time.Duration of the specified number of seconds, rounded to the nearest nanosecond
+//   - returns an error, if the duration is out of the range of time.Duration
+func ToDuration(seconds *big.Rat) (r time.Duration, e error) {
+	if seconds == nil {
+		e = fmt.Errorf("nil duration")
+		return
+	}
+	ns := roundRat(new(big.Rat).Mul(seconds, big.NewRat(int64(time.Second), 1)), RoundNearest)
+	if !ns.IsInt64() {
+		e = fmt.Errorf("duration out of range [ %s s ]", seconds.FloatString(defaultPrecision))
+		return
+	}
+	r = time.Duration(ns.Int64())
+	return
+}
+
+// the options of a single decoding
+type decodeOptions struct {
+	precision int
+	trim      bool
+	rounding  Rounding
+	units     []string
+}
+
+// returns the options of decoding the specified hub data
+func readOptions(v *big.Rat, opts0 []xl8r.Opts) (r decodeOptions, e error) {
+	r = decodeOptions{precision: defaultPrecision, trim: true, rounding: RoundNearest}
+	if v == nil {
+		e = fmt.Errorf("nil duration")
+		return
+	}
+	if len(opts0) == 0 {
+		return
+	}
+	decoderOpts := opts0[0].Dec
+	if xValue, exists := decoderOpts[OptPrecision]; exists {
+		precision, ok := xValue.(int)
+		if !ok || precision < 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptPrecision, xValue)
+			return
+		}
+		r.precision, r.trim = precision, false
+	}
+	if xValue, exists := decoderOpts[OptRounding]; exists {
+		rounding, ok := xValue.(Rounding)
+		if !ok || (rounding != RoundNearest && rounding != RoundDown && rounding != RoundUp) {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptRounding, xValue)
+			return
+		}
+		r.rounding = rounding
+	}
+	if xValue, exists := decoderOpts[OptUnits]; exists {
+		units, ok := xValue.([]string)
+		if !ok || len(units) == 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptUnits, xValue)
+			return
+		}
+		r.units = units
+	}
+	return
+}
+
+// rounds the specified value to an integer
+func roundRat(x *big.Rat, rounding Rounding) (r *big.Int) {
+	num, den := new(big.Int).Abs(x.Num()), x.Denom()
+	rem := new(big.Int)
+	switch rounding {
+	case RoundDown:
+		r = new(big.Int).Quo(num, den)
+	case RoundUp:
+		if r, _ = new(big.Int).QuoRem(num, den, rem); rem.Sign() != 0 {
+			r.Add(r, big.NewInt(1))
+		}
+	default:
+		twice := new(big.Int).Lsh(num, 1)
+		r = new(big.Int).Quo(twice.Add(twice, den), new(big.Int).Lsh(den, 1))
+	}
+	if x.Sign() < 0 {
+		r.Neg(r)
+	}
+	return
+}
+
+// formats a non-negative number of ticks (of 10^-precision), as a decimal number
+func formatTicks(ticks *big.Int, precision int, trim bool) (r string) {
+	s := ticks.String()
+	if len(s) <= precision {
+		s = strings.Repeat("0", precision-len(s)+1) + s
+	}
+	whole, frac := s[:len(s)-precision], s[len(s)-precision:]
+	if trim {
+		frac = strings.TrimRight(frac, "0")
+	}
+	r = whole
+	if len(frac) > 0 {
+		r += "." + frac
+	}
+	return
+}
+
+// parses a non-negative decimal number (eg. "1", "1.5", ".5" or "1,5")
+func parseDecimal(s string) (r *big.Rat, e error) {
+	s = strings.Replace(s, ",", ".", 1)
+	if strings.HasSuffix(s, ".") {
+		s += "0"
+	}
+	var ok bool
+	if len(s) == 0 || strings.Trim(s, "0123456789.") != "" || strings.Count(s, ".") > 1 {
+		e = fmt.Errorf("invalid number [ %s ]", s)
+		return
+	}
+	if r, ok = new(big.Rat).SetString(s); !ok {
+		e = fmt.Errorf("invalid number [ %s ]", s)
+	}
+	return
+}
+
+// returns the codecs of this package
+//   - "iso8601" (eg. "P1DT2H30M"), "go" (eg. "26h30m"), "hh:mm:ss" (eg. "26:30:00.5"),
+//     "units" (eg. "1d 2h 30m")
+//   - single-unit codecs: "weeks", "days", "hours", "minutes", "seconds",
+//     "milliseconds", "microseconds" and "nanoseconds" (eg. "90 minutes")
+func Codecs() (r []xl8r.Codec[string, *big.Rat]) {
+	r = append(r, ISO8601(), GoDuration(), Clock(), Units())
+	for _, u := range allUnits {
+		r = append(r, SingleUnit(u.plural))
+	}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, *big.Rat], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
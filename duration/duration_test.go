@@ -0,0 +1,126 @@
+package duration
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestDurations(t *testing.T) {
+	convertDuration, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "iso8601", from: "go", text: "1h30m0.5s", expected: "PT1H30M0.5S"},
+		{to: "iso8601", from: "go", text: "0s", expected: "PT0S"},
+		{to: "iso8601", from: "go", text: "-48h", expected: "-P2D"},
+		{to: "iso8601", from: "units", text: "1w 2d 3h", expected: "P9DT3H"},
+		{to: "go", from: "iso8601", text: "P1DT0,5S", expected: "24h0m0.5s"},
+		{to: "go", from: "iso8601", text: "p0y0m1w", expected: "168h0m0s"},
+		{to: "go", from: "iso8601", text: "P1Y", expectedErr: true},
+		{to: "go", from: "iso8601", text: "PT", expectedErr: true},
+		{to: "go", from: "iso8601", text: "P", expectedErr: true},
+		{to: "hh:mm:ss", from: "minutes", text: "90 minutes", expected: "1:30:00"},
+		{to: "hh:mm:ss", from: "minutes", text: "2600 min", expected: "43:20:00"},
+		{to: "hh:mm:ss", from: "seconds", text: "-1.25 secs", expected: "-0:00:01.25"},
+		{to: "minutes", from: "hh:mm:ss", text: "01:30:00", expected: "90 minutes"},
+		{to: "minutes", from: "hh:mm:ss", text: "0:01:00", expected: "1 minute"},
+		{to: "hours", from: "hh:mm:ss", text: "0:20:00", expected: "0.333333333 hours"},
+		{to: "units", from: "go", text: "26h3m0.001s", expected: "1d 2h 3m 0.001s"},
+		{to: "units", from: "go", text: "0", expected: "0s"},
+		{to: "units", from: "units", text: "2h, 30 mins", expected: "2h 30m"},
+		{to: "milliseconds", from: "units", text: "1.5 Seconds", expected: "1500 milliseconds"},
+		{to: "nanoseconds", from: "go", text: "1µs", expected: "1000 nanoseconds"},
+		{to: "go", from: "weeks", text: "100000 weeks", expectedErr: true}, // out of range of time.Duration
+		{to: "units", from: "units", text: "2 fortnights", expectedErr: true},
+		{to: "hh:mm:ss", from: "hh:mm:ss", text: "1:60:00", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertDuration.To(tx.to, tx.from, tx.text)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s") ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, result, tErr, tx.expected)
+		}
+	}
+
+	// Origins(..) reports codecs in no particular order
+	origins, expected := convertDuration.Origins("90 min"), []string{"units", "minutes"}
+	sort.Strings(origins)
+	sort.Strings(expected)
+	if strings.Join(origins, ",") != strings.Join(expected, ",") {
+		t.Errorf(`Origins("90 min") ==>> %v, expected %v`, origins, expected)
+	}
+}
+
+func TestDurationOptions(t *testing.T) {
+	convertDuration, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := func(kv ...any) xl8r.Opts {
+		dec := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			dec[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Dec: dec}
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		opts                     xl8r.Opts
+		expectedErr              bool
+	}{
+		{to: "minutes", from: "go", text: "1h0m30s", opts: opts(OptPrecision, 2), expected: "60.50 minutes"},
+		{to: "minutes", from: "go", text: "1h0m30s", opts: opts(OptPrecision, 0), expected: "61 minutes"},
+		{to: "minutes", from: "go", text: "1h0m30s", opts: opts(OptPrecision, 0, OptRounding, RoundDown), expected: "60 minutes"},
+		{to: "minutes", from: "go", text: "1h0m1s", opts: opts(OptPrecision, 0, OptRounding, RoundUp), expected: "61 minutes"},
+		{to: "hh:mm:ss", from: "go", text: "59.9996s", opts: opts(OptPrecision, 3), expected: "0:01:00.000"},
+		{to: "iso8601", from: "go", text: "1m59.6s", opts: opts(OptPrecision, 0), expected: "PT2M"},
+		{to: "units", from: "go", text: "26h30m", opts: opts(OptUnits, []string{"hours", "m"}), expected: "26h 30m"},
+		{to: "units", from: "go", text: "26h30m", opts: opts(OptUnits, []string{"d", "h"}), expected: "1d 2.5h"},
+		{to: "units", from: "go", text: "26h30m", opts: opts(OptUnits, []string{"d"}, OptPrecision, 1), expected: "1.1d"},
+		{to: "go", from: "seconds", text: "1.0000000005 s", opts: opts(OptRounding, RoundDown), expected: "1s"},
+		{to: "units", from: "go", text: "1h", opts: opts(OptUnits, []string{"fortnights"}), expectedErr: true},
+		{to: "units", from: "go", text: "1h", opts: opts(OptPrecision, "2"), expectedErr: true},
+		{to: "units", from: "go", text: "1h", opts: opts(OptRounding, "sideways"), expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertDuration.To(tx.to, tx.from, tx.text, tx.opts)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s",%v) ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, tx.opts.Dec, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestSecondsAndToDuration(t *testing.T) {
+	d := 90*time.Minute + 500*time.Nanosecond
+	seconds := Seconds(d)
+	if seconds.Cmp(big.NewRat(5400000000500, 1e9)) != 0 {
+		t.Errorf("Seconds(%v) ==>> %v", d, seconds)
+	}
+	if back, err := ToDuration(seconds); err != nil || back != d {
+		t.Errorf("ToDuration(%v) ==>> %v %v", seconds, back, err)
+	}
+	if _, err := ToDuration(big.NewRat(1e12, 1)); err == nil {
+		t.Errorf("expected an out of range error")
+	}
+
+	// any format may be decoded from a time.Duration
+	codec := Clock()
+	if text, err := codec.Decode(Seconds(d)); err != nil || text != "1:30:00.0000005" {
+		t.Errorf(`Decode(%v) ==>> "%s" %v`, d, text, err)
+	}
+	if _, err := codec.Decode(nil); err == nil {
+		t.Errorf("expected a nil duration error")
+	}
+}
@@ -0,0 +1,363 @@
+package duration
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// a unit of time
+type unit struct {
+	symbol, singular, plural string
+	// the accepted (lower-case) names of the unit
+	names   []string
+	seconds *big.Rat
+}
+
+// all units, from the largest to the smallest
+var allUnits = []unit{
+	{symbol: "w", singular: "week", plural: "weeks", names: []string{"w", "wk", "wks", "week", "weeks"}, seconds: big.NewRat(7*24*3600, 1)},
+	{symbol: "d", singular: "day", plural: "days", names: []string{"d", "day", "days"}, seconds: big.NewRat(24*3600, 1)},
+	{symbol: "h", singular: "hour", plural: "hours", names: []string{"h", "hr", "hrs", "hour", "hours"}, seconds: big.NewRat(3600, 1)},
+	{symbol: "m", singular: "minute", plural: "minutes", names: []string{"m", "min", "mins", "minute", "minutes"}, seconds: big.NewRat(60, 1)},
+	{symbol: "s", singular: "second", plural: "seconds", names: []string{"s", "sec", "secs", "second", "seconds"}, seconds: big.NewRat(1, 1)},
+	{symbol: "ms", singular: "millisecond", plural: "milliseconds", names: []string{"ms", "msec", "msecs", "millisecond", "milliseconds"}, seconds: big.NewRat(1, 1e3)},
+	{symbol: "us", singular: "microsecond", plural: "microseconds", names: []string{"us", "µs", "μs", "usec", "usecs", "microsecond", "microseconds"}, seconds: big.NewRat(1, 1e6)},
+	{symbol: "ns", singular: "nanosecond", plural: "nanoseconds", names: []string{"ns", "nsec", "nsecs", "nanosecond", "nanoseconds"}, seconds: big.NewRat(1, 1e9)},
+}
+
+// returns the unit with the specified (case-insensitive) name
+func lookupUnit(name string) (r unit, exists bool) {
+	name = strings.ToLower(name)
+	for _, u := range allUnits {
+		for _, n := range u.names {
+			if n == name {
+				r, exists = u, true
+				return
+			}
+		}
+	}
+	return
+}
+
+// the pattern of a non-negative decimal number
+const decimalPattern = `\d+(?:[.,]\d+)?|[.,]\d+`
+
+// splits the specified number of seconds into the specified units (from the largest to the smallest)
+//   - the largest units get whole numbers, the smallest unit gets the rest (rounded, as per the options)
+func splitSeconds(seconds *big.Rat, units []unit, o decodeOptions) (negative bool, parts []string) {
+	smallest := units[len(units)-1]
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(o.precision)), nil))
+
+	ticks := new(big.Rat).Quo(seconds, smallest.seconds)
+	n := roundRat(ticks.Mul(ticks, scale), o.rounding)
+	negative = n.Sign() < 0
+	n.Abs(n)
+	for _, u := range units[:len(units)-1] {
+		per := new(big.Rat).Quo(u.seconds, smallest.seconds)
+		per.Mul(per, scale)
+		q := new(big.Int)
+		q.QuoRem(n, per.Num(), n)
+		parts = append(parts, q.String())
+	}
+	parts = append(parts, formatTicks(n, o.precision, o.trim))
+	return
+}
+
+func signOf(negative bool) string {
+	if negative {
+		return "-"
+	}
+	return ""
+}
+
+func applySign(sign string, r *big.Rat) *big.Rat {
+	if sign == "-" {
+		r.Neg(r)
+	}
+	return r
+}
+
+func mustBuild(c *xl8r.RegexCodec[string, *big.Rat]) *xl8r.Spoke[string, *big.Rat] {
+	r, err := c.Build()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// returns the codec for ISO 8601 durations (eg. "P1DT2H30M", "PT0.5S" or "-P1W")
+//   - years and months have no fixed length, so only zero years and months are accepted
+//   - decoding produces days, hours, minutes and seconds
+func ISO8601() (r *xl8r.Spoke[string, *big.Rat]) {
+	n := `(?:` + decimalPattern + `)`
+	r = mustBuild(&xl8r.RegexCodec[string, *big.Rat]{
+		Id: "iso8601",
+		Pattern: strings.ReplaceAll(`(?i)^\s*(?P<sign>[-+])?P`+
+			`(?:(?P<y>N)Y)?(?:(?P<mo>N)M)?(?:(?P<w>N)W)?(?:(?P<d>N)D)?`+
+			`(?:(?P<t>T)(?:(?P<h>N)H)?(?:(?P<mi>N)M)?(?:(?P<s>N)S)?)?\s*$`, "N", n),
+		Hub: func(g *xl8r.Groups) (r *big.Rat, e error) {
+			r = new(big.Rat)
+			components := 0
+			for _, c := range []struct {
+				group string
+				u     int
+			}{{"y", -1}, {"mo", -1}, {"w", 0}, {"d", 1}, {"h", 2}, {"mi", 3}, {"s", 4}} {
+				if !g.Has(c.group) {
+					continue
+				}
+				components++
+				value, err := parseDecimal(g.String(c.group))
+				if err != nil {
+					e = &xl8r.GroupError{Group: c.group, Value: g.String(c.group), Err: err}
+					return
+				}
+				if c.u < 0 {
+					if value.Sign() != 0 {
+						e = &xl8r.GroupError{Group: c.group, Value: g.String(c.group), Err: fmt.Errorf("years and months have no fixed length")}
+						return
+					}
+					continue
+				}
+				r.Add(r, value.Mul(value, allUnits[c.u].seconds))
+			}
+			if components == 0 || (g.Has("t") && !(g.Has("h") || g.Has("mi") || g.Has("s"))) {
+				e = fmt.Errorf("no duration components")
+				return
+			}
+			r = applySign(g.String("sign"), r)
+			return
+		},
+		Format: func(v *big.Rat, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(v, opts0); e != nil {
+				return
+			}
+			negative, parts := splitSeconds(v, allUnits[1:5], o)
+			buf := &strings.Builder{}
+			buf.WriteString(signOf(negative) + "P")
+			if parts[0] != "0" {
+				buf.WriteString(parts[0] + "D")
+			}
+			clock := &strings.Builder{}
+			for i, designator := range []string{"H", "M", "S"} {
+				if part := parts[i+1]; strings.Trim(part, "0.") != "" {
+					clock.WriteString(part + designator)
+				}
+			}
+			if clock.Len() > 0 {
+				buf.WriteString("T" + clock.String())
+			} else if parts[0] == "0" {
+				buf.WriteString("T0S")
+			}
+			r = buf.String()
+			return
+		},
+	})
+	return
+}
+
+var goDurationComponent = regexp.MustCompile(`(\d+(?:\.\d*)?|\.\d+)(ns|us|µs|μs|ms|s|m|h)`)
+
+// returns the codec for Go time.Duration strings (eg. "1h30m", "1.5s" or "-300ms")
+//   - encoding is not limited to the range of time.Duration
+//   - decoding rounds to whole nanoseconds, and fails for durations out of the range of time.Duration
+func GoDuration() (r *xl8r.Spoke[string, *big.Rat]) {
+	r = mustBuild(&xl8r.RegexCodec[string, *big.Rat]{
+		Id:      "go",
+		Pattern: `^\s*(?P<sign>[-+])?(?P<components>(?:(?:\d+(?:\.\d*)?|\.\d+)(?:ns|us|µs|μs|ms|s|m|h))+|0)\s*$`,
+		Hub: func(g *xl8r.Groups) (r *big.Rat, e error) {
+			r = new(big.Rat)
+			for _, m := range goDurationComponent.FindAllStringSubmatch(g.String("components"), -1) {
+				value, err := parseDecimal(m[1])
+				if err != nil {
+					e = &xl8r.GroupError{Group: "components", Value: m[0], Err: err}
+					return
+				}
+				u, _ := lookupUnit(m[2])
+				r.Add(r, value.Mul(value, u.seconds))
+			}
+			r = applySign(g.String("sign"), r)
+			return
+		},
+		Format: func(v *big.Rat, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(v, opts0); e != nil {
+				return
+			}
+			ns := roundRat(new(big.Rat).Mul(v, big.NewRat(int64(time.Second), 1)), o.rounding)
+			if !ns.IsInt64() {
+				e = fmt.Errorf("duration out of range [ %s s ]", v.FloatString(defaultPrecision))
+				return
+			}
+			r = time.Duration(ns.Int64()).String()
+			return
+		},
+	})
+	return
+}
+
+// returns the codec for clock-like durations, in hours, minutes and seconds (eg. "26:30:00" or "0:00:01.5")
+func Clock() (r *xl8r.Spoke[string, *big.Rat]) {
+	r = mustBuild(&xl8r.RegexCodec[string, *big.Rat]{
+		Id:      "hh:mm:ss",
+		Pattern: `^\s*(?P<sign>[-+])?(?P<h>\d+):(?P<m>[0-5]?\d):(?P<s>[0-5]?\d(?:[.,]\d+)?)\s*$`,
+		Hub: func(g *xl8r.Groups) (r *big.Rat, e error) {
+			r = new(big.Rat)
+			for i, group := range []string{"h", "m", "s"} {
+				value, err := parseDecimal(g.String(group))
+				if err != nil {
+					e = &xl8r.GroupError{Group: group, Value: g.String(group), Err: err}
+					return
+				}
+				r.Add(r, value.Mul(value, allUnits[i+2].seconds))
+			}
+			r = applySign(g.String("sign"), r)
+			return
+		},
+		Format: func(v *big.Rat, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(v, opts0); e != nil {
+				return
+			}
+			negative, parts := splitSeconds(v, allUnits[2:5], o)
+			seconds := parts[2]
+			if whole, _, _ := strings.Cut(seconds, "."); len(whole) < 2 {
+				seconds = "0" + seconds
+			}
+			r = fmt.Sprintf("%s%s:%02s:%s", signOf(negative), parts[0], parts[1], seconds)
+			return
+		},
+	})
+	return
+}
+
+var (
+	unitsPattern   = regexp.MustCompile(`(?i)^\s*([-+])?\s*((?:(?:` + decimalPattern + `)\s*[a-zµμ]+[\s,]*)+)$`)
+	unitsComponent = regexp.MustCompile(`(?i)(` + decimalPattern + `)\s*([a-zµμ]+)`)
+)
+
+// the units used by the "units" codec, when no units are specified
+var defaultUnits = []string{"w", "d", "h", "m", "s"}
+
+// returns the codec for durations written as numbers of units (eg. "1w 2d 3h", "1.5 hours" or "2h, 30min")
+//   - when decoding, zero units are omitted, and the units used may be selected with OptUnits
+func Units() (r *xl8r.Spoke[string, *big.Rat]) {
+	encode := func(v string, _ ...xl8r.Opts) (r *big.Rat, e error) {
+		m := unitsPattern.FindStringSubmatch(v)
+		if m == nil {
+			e = fmt.Errorf("could not parse -- %v", v)
+			return
+		}
+		r = new(big.Rat)
+		for _, c := range unitsComponent.FindAllStringSubmatch(m[2], -1) {
+			u, exists := lookupUnit(c[2])
+			if !exists {
+				e = fmt.Errorf("unknown unit [ %s ] in -- %v", c[2], v)
+				return
+			}
+			value, err := parseDecimal(c[1])
+			if err != nil {
+				e = err
+				return
+			}
+			r.Add(r, value.Mul(value, u.seconds))
+		}
+		r = applySign(m[1], r)
+		return
+	}
+	r = &xl8r.Spoke[string, *big.Rat]{
+		Id:  "units",
+		Enc: encode,
+		Dec: func(v *big.Rat, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(v, opts0); e != nil {
+				return
+			}
+			names := o.units
+			if len(names) == 0 {
+				names = defaultUnits
+			}
+			var units []unit
+			seen := make(map[string]bool)
+			for _, name := range names {
+				u, exists := lookupUnit(name)
+				if !exists {
+					e = fmt.Errorf("invalid option [ %s ]: unknown unit [ %s ]", OptUnits, name)
+					return
+				}
+				if !seen[u.symbol] {
+					seen[u.symbol] = true
+					units = append(units, u)
+				}
+			}
+			sort.Slice(units, func(i, j int) bool { return units[i].seconds.Cmp(units[j].seconds) > 0 })
+
+			negative, parts := splitSeconds(v, units, o)
+			var nonZero []string
+			for i, part := range parts {
+				if strings.Trim(part, "0.") != "" {
+					nonZero = append(nonZero, part+units[i].symbol)
+				}
+			}
+			if len(nonZero) == 0 {
+				nonZero = append(nonZero, parts[len(parts)-1]+units[len(units)-1].symbol)
+				negative = false
+			}
+			r = signOf(negative) + strings.Join(nonZero, " ")
+			return
+		},
+		Check: func(v string) (r bool) {
+			_, err := encode(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns the codec for durations written in a single unit (eg. "90 minutes" or "1.5h")
+//   - the unit is named by its plural name (eg. "minutes"), which is also the name of the codec
+//   - panics, if the unit is unknown
+func SingleUnit(name string) (r *xl8r.Spoke[string, *big.Rat]) {
+	u, exists := lookupUnit(name)
+	if !exists {
+		panic(fmt.Sprintf("duration: unknown unit '%s'", name))
+	}
+	names := append([]string(nil), u.names...)
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	for i, n := range names {
+		names[i] = regexp.QuoteMeta(n)
+	}
+	r = mustBuild(&xl8r.RegexCodec[string, *big.Rat]{
+		Id:      u.plural,
+		Pattern: `(?i)^\s*(?P<sign>[-+])?\s*(?P<n>` + decimalPattern + `)\s*(?:` + strings.Join(names, "|") + `)\s*$`,
+		Hub: func(g *xl8r.Groups) (r *big.Rat, e error) {
+			if r, e = parseDecimal(g.String("n")); e != nil {
+				e = &xl8r.GroupError{Group: "n", Value: g.String("n"), Err: e}
+				return
+			}
+			r = applySign(g.String("sign"), r.Mul(r, u.seconds))
+			return
+		},
+		Format: func(v *big.Rat, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(v, opts0); e != nil {
+				return
+			}
+			negative, parts := splitSeconds(v, []unit{u}, o)
+			unitName := u.plural
+			if parts[0] == "1" {
+				unitName = u.singular
+			}
+			r = fmt.Sprintf("%s%s %s", signOf(negative), parts[0], unitName)
+			return
+		},
+	})
+	return
+}
@@ -1,3 +1,3 @@
 module github.com/eenti-utils/xl8r
 
-go 1.18
+go 1.21
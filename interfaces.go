@@ -12,8 +12,45 @@ type Interpreter[P, H any] interface {
 	Encode(origin string, content P, opts0 ...Opts) (hubDataResult H, e error)
 	// returns the names of all codecs with an encoder function that can process the specified content
 	Origins(content0 ...P) (r []string)
+	// returns the names of the codecs within the specified namespace,
+	// with an encoder function that can process the specified content
+	OriginsIn(namespace string, content0 ...P) (r []string)
+	// returns the sorted names of all codecs within the specified namespace
+	//   - namespaces are separated by "/" within codec names (eg. "lang/ja/kana")
+	//   - an empty namespace lists all codecs
+	List(namespace string) (r []string)
+	// returns a view of the Interpreter, that is scoped to the specified namespace
+	//   - codec names passed to, and returned by, the view are relative to the namespace
+	Namespace(namespace string) (r Interpreter[P, H])
+	// returns a read-only view of the Interpreter, that exposes only the specified codecs
+	//   - an unversioned name exposes all versions of the codec
+	Subset(names ...string) (r Interpreter[P, H])
+	// returns a read-only view of the Interpreter, that exposes only the codecs
+	// whose names are accepted by the specified predicate
+	Filter(predicate func(name string) bool) (r Interpreter[P, H])
+	// adds the specified codecs, calling Init() on those that implement Initializer
+	//   - fails if a codec is invalid, or its name is already registered
+	//   - returns ErrReadOnly, when called on a view
+	Register(codecs ...Codec[P, H]) (e error)
+	// calls Close() on each registered codec that implements io.Closer,
+	// in reverse order of registration, and returns the joined errors
+	//   - returns ErrReadOnly, when called on a view
+	Close() (e error)
+	// reports the status of each codec
+	//   - codecs that do not implement HealthChecker are reported with a nil error
+	Health() (r map[string]error)
 	// returns bool true, if the specified codec has been registered
 	Knows(name string) (r bool)
+	// returns the names of all registered versions of the specified codec, oldest first
+	//   - codecs are versioned by name, as "name@version" (eg. "kana@2")
+	//   - "name@latest" addresses the latest version, as does an unversioned "name",
+	//     unless a codec is registered under that very name
+	//   - a version has a single spelling (eg. "kana@1" and "kana@1.0" cannot both be registered)
+	//   - an unversioned codec precedes all of its versions (eg. "kana" precedes "kana@1")
+	Versions(name string) (r []string)
+	// returns a snapshot of the circuit breaker for the specified codec
+	//   - returns bool false, if the codec has no circuit breaker
+	Breaker(name string) (r BreakerStatus, b bool)
 }
 
 // a Codec handles conversion of
@@ -1,15 +1,50 @@
 package xl8r
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
 
 var _ Interpreter[int, int] = (*convertr[int, int])(nil) //contract
 
 type convertr[P, H any] struct {
+	*core[P, H]
+	// namespace of the view, including the trailing separator (eg. "lang/ja/")
+	//   - empty for the Interpreter returned by New(..)
+	scope string
+	// optional function that returns bool true, if the view exposes the named codec
+	//   - nil for the Interpreter returned by New(..)
+	allow func(name string) bool
+}
+
+// the state shared by an Interpreter, and all of its views
+type core[P, H any] struct {
+	mu sync.RWMutex
+	// registered codecs, replaced (never modified) by each registration
 	codecs codecMap[P, H]
+	// names of the registered codecs, in order of registration
+	order  []string
+	closed bool
+
+	cfg      Config
+	limiters map[string]*limiter
+	breakers map[string]*breaker
 }
 
 // creates a new Interpreter instance based on the specified Codecs
 func New[P, H any](codecs ...Codec[P, H]) (r Interpreter[P, H], e error) {
+	r, e = NewWithConfig(Config{}, codecs...)
+	return
+}
+
+// creates a new Interpreter instance based on the specified Config and Codecs
+func NewWithConfig[P, H any](cfg Config, codecs ...Codec[P, H]) (r Interpreter[P, H], e error) {
+	for _, c := range codecs {
+		if e = checkVersion(c); e != nil {
+			return
+		}
+	}
 	cdMap := make(codecMap[P, H])
 	cdMap.addCodecs(codecs...)
 
@@ -17,21 +52,59 @@ func New[P, H any](codecs ...Codec[P, H]) (r Interpreter[P, H], e error) {
 		e = fmt.Errorf("need codecs > 1, received [ %d ]", numCodecs)
 		return
 	}
-	r = &convertr[P, H]{
-		codecs: cdMap,
+	x := &convertr[P, H]{core: &core[P, H]{
+		codecs:   make(codecMap[P, H]),
+		cfg:      cfg,
+		limiters: newLimiters(cfg.Policies),
+	}}
+	x.breakers = newBreakers(cfg.Policies, x.clock())
+
+	// register the codecs in the specified order,
+	// skipping the invalid ones and those replaced by a later codec of the same name
+	valid := make([]Codec[P, H], 0, len(cdMap))
+	seen := make(map[string]bool)
+	for i := len(codecs) - 1; i >= 0; i-- {
+		if c := codecs[i]; codecIsValid(c) && !seen[c.Name()] {
+			seen[c.Name()] = true
+			valid = append([]Codec[P, H]{c}, valid...)
+		}
 	}
+	if e = x.register(valid); e != nil {
+		return
+	}
+	r = x
+	return
+}
+
+// returns the registered codecs
+func (c *core[P, H]) registered() (r codecMap[P, H]) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	r = c.codecs
 	return
 }
 
 func (x *convertr[P, H]) getCodecIf(name string) (c Codec[P, H], b bool) {
-	return x.codecs.getIf(name)
+	codecs := x.registered()
+	return codecs.resolve(x.scope+name, x.visible)
+}
+
+// returns bool true, if the specified codec is exposed by the view
+func (x *convertr[P, H]) visible(name string) (r bool) {
+	r = strings.HasPrefix(name, x.scope) && (x.allow == nil || x.allow(name))
+	return
 }
 
 func (x *convertr[P, H]) To(dest, source string, content P, opts0 ...Opts) (r P, e error) {
+	t := x.startTranslation(source, dest, StageEncode, content)
+	defer func() { x.endTranslation(t, e) }()
+
 	if origin, hasOrigin := x.getCodecIf(source); hasOrigin {
 		if destination, hasDestination := x.getCodecIf(dest); hasDestination {
-			if hubData, err := origin.Encode(content, opts0...); err == nil {
-				r, e = destination.Decode(hubData, opts0...)
+			if hubData, err := x.encode(origin.Name(), origin, content, x.scopedOpts(t, opts0)); err == nil {
+				t.reached(StageDecode)
+				r, e = x.decode(destination.Name(), destination, hubData, x.scopedOpts(t, opts0))
+				t.finished(r)
 				return
 			} else {
 				e = err
@@ -46,8 +119,12 @@ func (x *convertr[P, H]) To(dest, source string, content P, opts0 ...Opts) (r P,
 }
 
 func (x *convertr[P, H]) Decode(dest string, hubData H, opts0 ...Opts) (r P, e error) {
+	t := x.startTranslation("", dest, StageDecode, hubData)
+	defer func() { x.endTranslation(t, e) }()
+
 	if destination, hasDestination := x.getCodecIf(dest); hasDestination {
-		r, e = destination.Decode(hubData, opts0...)
+		r, e = x.decode(destination.Name(), destination, hubData, x.scopedOpts(t, opts0))
+		t.finished(r)
 	} else {
 		e = fmt.Errorf("no decoder [ '%s'<- ]", dest)
 	}
@@ -55,8 +132,12 @@ func (x *convertr[P, H]) Decode(dest string, hubData H, opts0 ...Opts) (r P, e e
 }
 
 func (x *convertr[P, H]) Encode(source string, content P, opts0 ...Opts) (r H, e error) {
+	t := x.startTranslation(source, "", StageEncode, content)
+	defer func() { x.endTranslation(t, e) }()
+
 	if origin, hasOrigin := x.getCodecIf(source); hasOrigin {
-		r, e = origin.Encode(content, opts0...)
+		r, e = x.encode(origin.Name(), origin, content, x.scopedOpts(t, opts0))
+		t.finished(r)
 	} else {
 		e = fmt.Errorf("no encoder [ <-'%s' ]", source)
 	}
@@ -64,19 +145,32 @@ func (x *convertr[P, H]) Encode(source string, content P, opts0 ...Opts) (r H, e
 }
 
 func (x *convertr[P, H]) Origins(content0 ...P) (r []string) {
-	if len(content0) == 0 {
-		r = x.codecs.keys()
-		return
+	r = x.origins(x.scope, content0)
+	return
+}
+
+func (x *convertr[P, H]) Versions(name string) (r []string) {
+	codecs := x.registered()
+	for _, version := range codecs.versions(x.scope+name, x.visible) {
+		r = append(r, strings.TrimPrefix(version, x.scope))
 	}
-	codecs := make(codecMap[P, H])
-	for _, content := range content0 {
-		for name, origin := range x.codecs {
-			if _, exists := codecs.getIf(name); !exists && origin.Evaluate(content) {
-				codecs[name] = origin
-			}
+	return
+}
+
+func (x *convertr[P, H]) Breaker(name string) (r BreakerStatus, b bool) {
+	if c, exists := x.getCodecIf(name); exists {
+		var cb *breaker
+		if cb, b = policyLookup(x.breakers, c.Name()); b {
+			r = cb.status(c.Name())
 		}
 	}
-	r = codecs.keys()
+	return
+}
+
+func (x *convertr[P, H]) clock() (r Clock) {
+	if r = x.cfg.Clock; r == nil {
+		r = systemClock{}
+	}
 	return
 }
 
@@ -84,3 +178,28 @@ func (x *convertr[P, H]) Knows(name string) (r bool) {
 	_, r = x.getCodecIf(name)
 	return
 }
+
+// converts content into hub data, using the specified codec
+func (x *convertr[P, H]) encode(name string, c Codec[P, H], content P, opts0 []Opts) (r H, e error) {
+	r, e = invoke(x, name, StageEncode, func() (H, error) {
+		return c.Encode(content, opts0...)
+	})
+	return
+}
+
+// converts hub data into content, using the specified codec
+func (x *convertr[P, H]) decode(name string, c Codec[P, H], hubData H, opts0 []Opts) (r P, e error) {
+	r, e = invoke(x, name, StageDecode, func() (P, error) {
+		return c.Decode(hubData, opts0...)
+	})
+	return
+}
+
+// returns bool true, if the specified codec can process the content
+//   - a failed evaluation (eg. a recovered panic or an exceeded limit) returns bool false
+func (x *convertr[P, H]) evaluate(name string, c Codec[P, H], content P) (r bool) {
+	r, _ = invoke(x, name, StageEvaluate, func() (bool, error) {
+		return c.Evaluate(content), nil
+	})
+	return
+}
@@ -0,0 +1,358 @@
+package xl8r
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+/*
+LoadJSON creates a new Interpreter instance, from a JSON spec of table-based and regex-based codecs.
+
+The spec is a JSON object, with a single "codecs" array:
+
+	{
+	  "codecs": [
+	    {
+	      "name": "english",
+	      "type": "table",
+	      "caseSensitive": false,
+	      "entries": [
+	        { "hub": 1, "text": ["one", "1"] },
+	        { "hub": 2, "text": "two" }
+	      ]
+	    },
+	    {
+	      "name": "items",
+	      "type": "regex",
+	      "pattern": "^(?P<count>\\d+) items?$",
+	      "hub": "{{.count}}",
+	      "format": "{{.}} items"
+	    }
+	  ]
+	}
+
+A "table" codec maps whole (normalized) content to hub data values:
+  - "entries" pairs each JSON hub value with one or more texts
+  - the first text of an entry is the one produced when decoding
+  - content is trimmed, and its inner whitespace collapsed, before lookup
+  - texts are matched case-insensitively, unless "caseSensitive" is true (see TableCodec)
+
+A "regex" codec parses content with a regular expression, that has named capture groups:
+  - "pattern" is the regular expression, and also decides which content the codec can process
+  - "hub" is a text/template, executed with the named groups, that produces the JSON hub value
+  - "format" is a text/template, executed with the hub value, that produces the decoded content
+  - templates may use the "json" function, to produce JSON text (eg. {{json .name}})
+
+Spec errors are returned as a *SpecError, pointing to the JSON path of the offending value.
+*/
+func LoadJSON[H comparable](cfg Config, r io.Reader) (x Interpreter[string, H], e error) {
+	var data []byte
+	if data, e = io.ReadAll(r); e != nil {
+		return
+	}
+	var codecs []Codec[string, H]
+	if codecs, e = parseSpec[H](data); e != nil {
+		return
+	}
+	x, e = NewWithConfig(cfg, codecs...)
+	return
+}
+
+// the error returned for an invalid JSON spec
+type SpecError struct {
+	// the JSON path of the offending value (eg. "codecs[2].entries[0].hub")
+	Path string
+	Err  error
+}
+
+func (e *SpecError) Error() string {
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("invalid spec: %v", e.Err)
+	}
+	return fmt.Sprintf("invalid spec [ %s ]: %v", e.Path, e.Err)
+}
+
+func (e *SpecError) Unwrap() error {
+	return e.Err
+}
+
+func specErrorf(path, format string, args ...any) *SpecError {
+	return &SpecError{Path: path, Err: fmt.Errorf(format, args...)}
+}
+
+// decodes the JSON object at the specified path, into the specified fields
+//   - fields that are absent from the object are left as they are
+//   - fields are decoded in sorted order, so that the first offending field is always the one reported
+//   - returns a *SpecError, for malformed JSON, unknown fields and values of the wrong type
+func decodeSpecObject(raw json.RawMessage, path string, fields map[string]any) (e error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil || obj == nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			e = &SpecError{Path: path, Err: fmt.Errorf("malformed JSON at offset %d: %w", syntaxErr.Offset, err)}
+			return
+		}
+		e = specErrorf(path, "expected an object")
+		return
+	}
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := obj[key]
+		target, known := fields[key]
+		fieldPath := joinSpecPath(path, key)
+		if !known {
+			e = specErrorf(fieldPath, "unknown field")
+			return
+		}
+		if err := json.Unmarshal(value, target); err != nil {
+			e = &SpecError{Path: fieldPath, Err: err}
+			return
+		}
+	}
+	return
+}
+
+func joinSpecPath(path, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
+
+// accepts either a single JSON string, or an array of strings
+type specTexts []string
+
+func (t *specTexts) UnmarshalJSON(data []byte) (e error) {
+	var one string
+	if e = json.Unmarshal(data, &one); e == nil {
+		*t = specTexts{one}
+		return
+	}
+	var many []string
+	if e = json.Unmarshal(data, &many); e == nil {
+		*t = many
+	}
+	return
+}
+
+func parseSpec[H comparable](data []byte) (r []Codec[string, H], e error) {
+	var codecsRaw []json.RawMessage
+	if e = decodeSpecObject(data, "", map[string]any{"codecs": &codecsRaw}); e != nil {
+		return
+	}
+	if len(codecsRaw) < 2 {
+		e = specErrorf("codecs", "at least two codecs are required")
+		return
+	}
+
+	names := make(map[string]bool)
+	for i, raw := range codecsRaw {
+		path := fmt.Sprintf("codecs[%d]", i)
+		var name, kind string
+		var header map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &header); err != nil || header == nil {
+			e = specErrorf(path, "expected an object")
+			return
+		}
+		if err := json.Unmarshal(header["name"], &name); err != nil || len(name) == 0 {
+			e = specErrorf(joinSpecPath(path, "name"), "a non-empty string is required")
+			return
+		}
+		if !versionIsValid(name) {
+			e = specErrorf(joinSpecPath(path, "name"), "invalid codec name %q", name)
+			return
+		}
+		if names[name] {
+			e = specErrorf(joinSpecPath(path, "name"), "duplicate codec name %q", name)
+			return
+		}
+		names[name] = true
+		if err := json.Unmarshal(header["type"], &kind); err != nil {
+			e = specErrorf(joinSpecPath(path, "type"), "a string is required")
+			return
+		}
+
+		var c Codec[string, H]
+		switch kind {
+		case "table":
+			c, e = parseTableSpec[H](raw, path, name)
+		case "regex":
+			c, e = parseRegexSpec[H](raw, path, name)
+		default:
+			e = specErrorf(joinSpecPath(path, "type"), "unknown codec type %q", kind)
+		}
+		if e != nil {
+			return
+		}
+		r = append(r, c)
+	}
+	return
+}
+
+func parseTableSpec[H comparable](raw json.RawMessage, path, name string) (r Codec[string, H], e error) {
+	var caseSensitive bool
+	var entriesRaw []json.RawMessage
+	if e = decodeSpecObject(raw, path, map[string]any{
+		"name":          new(string),
+		"type":          new(string),
+		"caseSensitive": &caseSensitive,
+		"entries":       &entriesRaw,
+	}); e != nil {
+		return
+	}
+	if len(entriesRaw) == 0 {
+		e = specErrorf(joinSpecPath(path, "entries"), "at least one entry is required")
+		return
+	}
+
+	table := NewTableCodec[string, H](name)
+	table.CaseSensitive = caseSensitive
+	normalize := table.normalizer()
+	hubs := make(map[H]bool)
+	texts := make(map[string]bool)
+	for j, entryRaw := range entriesRaw {
+		entryPath := fmt.Sprintf("%s.entries[%d]", path, j)
+		var hubRaw json.RawMessage
+		var entryTexts specTexts
+		if e = decodeSpecObject(entryRaw, entryPath, map[string]any{
+			"hub":  &hubRaw,
+			"text": &entryTexts,
+		}); e != nil {
+			return
+		}
+		var hub H
+		if len(hubRaw) == 0 {
+			e = specErrorf(joinSpecPath(entryPath, "hub"), "a hub value is required")
+			return
+		}
+		if err := json.Unmarshal(hubRaw, &hub); err != nil {
+			e = &SpecError{Path: joinSpecPath(entryPath, "hub"), Err: err}
+			return
+		}
+		if hubs[hub] {
+			e = specErrorf(joinSpecPath(entryPath, "hub"), "duplicate hub value %s", hubRaw)
+			return
+		}
+		hubs[hub] = true
+		if len(entryTexts) == 0 {
+			e = specErrorf(joinSpecPath(entryPath, "text"), "at least one text is required")
+			return
+		}
+		for k, text := range entryTexts {
+			key := normalize(text)
+			textPath := fmt.Sprintf("%s.text[%d]", entryPath, k)
+			if len(key) == 0 {
+				e = specErrorf(textPath, "a non-empty text is required")
+				return
+			}
+			if texts[key] {
+				e = specErrorf(textPath, "duplicate text %q", text)
+				return
+			}
+			texts[key] = true
+		}
+		table.Add(hub, entryTexts[0], entryTexts[1:]...)
+	}
+
+	spoke, err := table.Build()
+	if err != nil {
+		e = &SpecError{Path: path, Err: err}
+		return
+	}
+	r = spoke
+	return
+}
+
+var specTemplateFuncs = template.FuncMap{
+	"json": func(v any) (r string, e error) {
+		var data []byte
+		if data, e = json.Marshal(v); e == nil {
+			r = string(data)
+		}
+		return
+	},
+}
+
+func parseRegexSpec[H comparable](raw json.RawMessage, path, name string) (r Codec[string, H], e error) {
+	var pattern, hubText, formatText string
+	if e = decodeSpecObject(raw, path, map[string]any{
+		"name":    new(string),
+		"type":    new(string),
+		"pattern": &pattern,
+		"hub":     &hubText,
+		"format":  &formatText,
+	}); e != nil {
+		return
+	}
+
+	var re *regexp.Regexp
+	var hubTmpl *template.Template
+	for _, field := range []struct {
+		key, value string
+		parse      func(string) error
+	}{
+		{key: "pattern", value: pattern, parse: func(s string) (err error) { re, err = regexp.Compile(s); return }},
+		{key: "hub", value: hubText, parse: func(s string) (err error) {
+			hubTmpl, err = template.New("hub").Funcs(specTemplateFuncs).Option("missingkey=error").Parse(s)
+			return
+		}},
+		{key: "format", value: formatText, parse: func(s string) (err error) {
+			_, err = template.New("format").Funcs(specTemplateFuncs).Parse(s)
+			return
+		}},
+	} {
+		fieldPath := joinSpecPath(path, field.key)
+		if len(field.value) == 0 {
+			e = specErrorf(fieldPath, "a non-empty string is required")
+			return
+		}
+		if err := field.parse(field.value); err != nil {
+			e = &SpecError{Path: fieldPath, Err: err}
+			return
+		}
+	}
+	if named := strings.Join(re.SubexpNames(), ""); len(named) == 0 {
+		e = specErrorf(joinSpecPath(path, "pattern"), "at least one named capture group is required")
+		return
+	}
+
+	spoke, err := (&RegexCodec[string, H]{
+		Id:      name,
+		Pattern: pattern,
+		Hub: func(g *Groups) (r H, e error) {
+			groups := make(map[string]string)
+			for _, group := range re.SubexpNames() {
+				if len(group) > 0 {
+					groups[group] = g.String(group)
+				}
+			}
+			buf := &bytes.Buffer{}
+			if e = hubTmpl.Execute(buf, groups); e != nil {
+				return
+			}
+			if err := json.Unmarshal(buf.Bytes(), &r); err != nil {
+				e = fmt.Errorf("invalid hub value %s -- %w", buf.String(), err)
+			}
+			return
+		},
+		Template: formatText,
+		Funcs:    specTemplateFuncs,
+	}).Build()
+	if err != nil {
+		e = &SpecError{Path: path, Err: err}
+		return
+	}
+	r = spoke
+	return
+}
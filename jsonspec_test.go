@@ -0,0 +1,147 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const testNumbersSpec = `{
+  "codecs": [
+    {
+      "name": "english",
+      "type": "table",
+      "entries": [
+        { "hub": 1, "text": ["one", "1"] },
+        { "hub": 2, "text": ["two", "2"] },
+        { "hub": 3, "text": "three" }
+      ]
+    },
+    {
+      "name": "spanish",
+      "type": "table",
+      "entries": [
+        { "hub": 1, "text": ["uno", "una"] },
+        { "hub": 2, "text": "dos" },
+        { "hub": 3, "text": "tres" }
+      ]
+    },
+    {
+      "name": "klingon",
+      "type": "table",
+      "caseSensitive": true,
+      "entries": [
+        { "hub": 1, "text": "wa’" },
+        { "hub": 3, "text": "wej" },
+        { "hub": 4, "text": "loS" }
+      ]
+    },
+    {
+      "name": "roman",
+      "type": "regex",
+      "pattern": "^(?P<ones>I{1,3})$",
+      "hub": "{{len .ones}}",
+      "format": "{{if eq . 1}}I{{else if eq . 2}}II{{else if eq . 3}}III{{else}}?{{end}}"
+    }
+  ]
+}`
+
+func TestLoadJSON(t *testing.T) {
+	translate, err := LoadJSON[int](Config{}, strings.NewReader(testNumbersSpec))
+	assrtNil(t, err)
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "spanish", from: "english", text: "one", expected: "uno"},
+		{to: "spanish", from: "english", text: " TWO ", expected: "dos"},
+		{to: "english", from: "spanish", text: "una", expected: "one"},
+		{to: "english", from: "roman", text: "III", expected: "three"},
+		{to: "roman", from: "klingon", text: "wa’", expected: "I"},
+		{to: "english", from: "klingon", text: "los", expectedErr: true},
+		{to: "english", from: "klingon", text: "loS", expectedErr: true}, // no english text for 4
+		{to: "english", from: "roman", text: "IV", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := translate.To(tx.to, tx.from, tx.text)
+		assrtEqual(t, tx.expected, result)
+		assrtEqual(t, tx.expectedErr, tErr != nil)
+		t.Logf(`# %d: To("%s","%s","%s") ==>> "%s" %v`, i, tx.to, tx.from, tx.text, result, tErr)
+	}
+
+	origins := translate.Origins("1")
+	assrtEqual(t, []string{"english"}, origins)
+	origins = translate.Origins("II", "dos")
+	sort.Strings(origins)
+	assrtEqual(t, []string{"roman", "spanish"}, origins)
+}
+
+func TestLoadJSONRegexStrings(t *testing.T) {
+	spec := `{"codecs": [
+		{"name": "hyphen", "type": "regex", "pattern": "^(?P<a>\\w+)-(?P<b>\\w+)$", "hub": "{{json (printf \"%s %s\" .a .b)}}", "format": "{{.}}"},
+		{"name": "slash", "type": "regex", "pattern": "^(?P<a>\\w+)/(?P<b>\\w+)$", "hub": "{{json (printf \"%s %s\" .a .b)}}", "format": "{{json .}}"}
+	]}`
+	translate, err := LoadJSON[string](Config{}, strings.NewReader(spec))
+	assrtNil(t, err)
+
+	_, tErr := translate.To("slash", "hyphen", `big-"cat"`)
+	assrtNotNil(t, tErr) // \w does not match quotes
+	result, tErr := translate.To("slash", "hyphen", "big-cat")
+	assrtNil(t, tErr)
+	assrtEqual(t, `"big cat"`, result)
+}
+
+func TestLoadJSONErrors(t *testing.T) {
+	tt := []struct {
+		spec, expectedPath string
+	}{
+		{spec: `[]`, expectedPath: ""},
+		{spec: `{"codecs": [}`, expectedPath: ""},
+		{spec: `{"codec": []}`, expectedPath: "codec"},
+		{spec: `{"codecs": [{"name": "a", "type": "table", "entries": [{"hub": 1, "text": "x"}]}]}`, expectedPath: "codecs"},
+		{spec: `{"codecs": [{"type": "table"}, {}]}`, expectedPath: "codecs[0].name"},
+		{spec: `{"codecs": [{"name": "a@x", "type": "table"}, {}]}`, expectedPath: "codecs[0].name"},
+		{spec: `{"codecs": [{"name": "a", "type": "tabel"}, {}]}`, expectedPath: "codecs[0].type"},
+		{spec: `{"codecs": [{"name": "a", "type": "table", "entries": [{"hub": 1, "text": "x"}]}, {"name": "a"}]}`, expectedPath: "codecs[1].name"},
+		{spec: `{"codecs": [{"name": "a", "type": "table", "entries": [{"hub": 1, "text": "x"}]}, {"name": "b", "type": "table", "entries": [{"hub": 1, "text": "x"}, {"hub": "two", "text": "y"}]}]}`, expectedPath: "codecs[1].entries[1].hub"},
+		{spec: `{"codecs": [{"name": "a", "type": "table", "entries": [{"hub": 1, "text": "x"}]}, {"name": "b", "type": "table", "entries": [{"hub": 1, "text": ["x", "y", "X"]}]}]}`, expectedPath: "codecs[1].entries[0].text[2]"},
+		{spec: `{"codecs": [{"name": "a", "type": "table", "entries": [{"hub": 1, "text": "x"}, {"hub": 1, "text": "y"}]}, {}]}`, expectedPath: "codecs[0].entries[1].hub"},
+		{spec: `{"codecs": [{"name": "a", "type": "table", "entries": [{"hub": 1, "txt": "x"}]}, {}]}`, expectedPath: "codecs[0].entries[0].txt"},
+		{spec: `{"codecs": [{"name": "a", "type": "table", "entries": []}, {}]}`, expectedPath: "codecs[0].entries"},
+		{spec: `{"codecs": [{"name": "a", "type": "regex", "pattern": "(", "hub": "1", "format": "x"}, {}]}`, expectedPath: "codecs[0].pattern"},
+		{spec: `{"codecs": [{"name": "a", "type": "regex", "pattern": "(\\d)", "hub": "1", "format": "x"}, {}]}`, expectedPath: "codecs[0].pattern"},
+		{spec: `{"codecs": [{"name": "a", "type": "regex", "pattern": "(?P<n>\\d)", "hub": "{{.n", "format": "x"}, {}]}`, expectedPath: "codecs[0].hub"},
+		{spec: `{"codecs": [{"name": "a", "type": "regex", "pattern": "(?P<n>\\d)", "hub": "{{.n}}"}, {}]}`, expectedPath: "codecs[0].format"},
+	}
+
+	for i, tx := range tt {
+		_, err := LoadJSON[int](Config{}, strings.NewReader(tx.spec))
+		var specErr *SpecError
+		assrtTrue(t, errors.As(err, &specErr), "# %d: expected a *SpecError, but was %v", i, err)
+		if specErr != nil {
+			assrtEqual(t, tx.expectedPath, specErr.Path)
+		}
+		t.Logf("# %d: %v", i, err)
+	}
+}
+
+func TestLoadJSONErrorDetails(t *testing.T) {
+	tt := []struct {
+		spec, expected string
+	}{
+		{spec: `{"codecs": [}`, expected: "invalid spec: malformed JSON at offset 13: invalid character '}' looking for beginning of value"},
+		{spec: `{"codecs": [`, expected: "invalid spec: malformed JSON at offset 12: unexpected end of JSON input"},
+		// with several offending fields, the first one in sorted order is reported
+		{spec: `{"codecs": [{"name": "a", "type": "table", "zz": 1, "entries": 2, "aa": 3}, {}]}`, expected: "invalid spec [ codecs[0].aa ]: unknown field"},
+	}
+	for i := 0; i < 10; i++ {
+		for _, tx := range tt {
+			_, err := LoadJSON[int](Config{}, strings.NewReader(tx.spec))
+			assrtEqual(t, tx.expected, fmt.Sprint(err))
+		}
+	}
+}
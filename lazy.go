@@ -0,0 +1,146 @@
+package xl8r
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+var _ Codec[int, int] = (*Lazy[int, int])(nil) //contract
+var _ io.Closer = (*Lazy[int, int])(nil)       //contract
+var _ HealthChecker = (*Lazy[int, int])(nil)   //contract
+
+// a function that constructs a codec
+type CodecFactory[P, H any] func() (r Codec[P, H], e error)
+
+// a named codec, that is constructed by its Factory on first use
+//   - construction happens once, and is safe for concurrent use
+//   - if the constructed codec implements Initializer, Init() is called right after construction
+type Lazy[P, H any] struct {
+	// name of the codec
+	Id string
+	// function that constructs the codec
+	Factory CodecFactory[P, H]
+	// optional function that returns bool true, if the specified content
+	// is processable by the encoder of the (not yet constructed) codec
+	//   - when nil, Evaluate(..) constructs the codec, and defers to it
+	Check Evaluator[P]
+	// when bool true, a failed construction is not retried,
+	// and its error is returned by all subsequent calls
+	CacheErrors bool
+
+	mu    sync.Mutex
+	built bool
+	codec Codec[P, H]
+	err   error
+}
+
+// name of the codec
+func (z *Lazy[P, H]) Name() string {
+	return z.Id
+}
+
+// returns the constructed codec, constructing it if necessary
+func (z *Lazy[P, H]) get() (r Codec[P, H], e error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	if z.built || (z.err != nil && z.CacheErrors) {
+		r, e = z.codec, z.err
+		return
+	}
+	if z.codec, z.err = z.construct(); z.err == nil {
+		z.built = true
+	}
+	r, e = z.codec, z.err
+	return
+}
+
+func (z *Lazy[P, H]) construct() (r Codec[P, H], e error) {
+	if z.Factory == nil {
+		e = fmt.Errorf("nil codec factory [ '%s' ]", z.Id)
+		return
+	}
+	if r, e = z.Factory(); e == nil && r == nil {
+		e = fmt.Errorf("nil codec")
+	}
+	if e == nil {
+		if initializer, ok := r.(Initializer); ok {
+			e = initializer.Init()
+		}
+	}
+	if e != nil {
+		r = nil
+		e = fmt.Errorf("codec construction failed [ '%s' ]: %w", z.Id, e)
+	}
+	return
+}
+
+// converts content into hub data, constructing the codec if necessary
+func (z *Lazy[P, H]) Encode(v P, opts0 ...Opts) (r H, e error) {
+	var c Codec[P, H]
+	if c, e = z.get(); e == nil {
+		r, e = c.Encode(v, opts0...)
+	}
+	return
+}
+
+// converts hub data into content, constructing the codec if necessary
+func (z *Lazy[P, H]) Decode(v H, opts0 ...Opts) (r P, e error) {
+	var c Codec[P, H]
+	if c, e = z.get(); e == nil {
+		r, e = c.Decode(v, opts0...)
+	}
+	return
+}
+
+// returns bool true, if the specified content is processable by the encoder
+//   - uses Check when specified, so that the codec need not be constructed
+//   - returns bool false, if the codec cannot be constructed
+func (z *Lazy[P, H]) Evaluate(v P) (r bool) {
+	if check := z.Check; check != nil {
+		r = check(v)
+		return
+	}
+	if c, err := z.get(); err == nil {
+		r = c.Evaluate(v)
+	}
+	return
+}
+
+// returns bool true, if the codec has been constructed
+func (z *Lazy[P, H]) Built() (r bool) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	r = z.built
+	return
+}
+
+// reports the health of the constructed codec
+//   - a codec that has not been constructed yet is considered healthy,
+//     unless its construction failed, and the error is cached
+func (z *Lazy[P, H]) Health() (e error) {
+	z.mu.Lock()
+	c, built, err := z.codec, z.built, z.err
+	z.mu.Unlock()
+
+	switch {
+	case built:
+		if checker, ok := c.(HealthChecker); ok {
+			e = checker.Health()
+		}
+	case z.CacheErrors:
+		e = err
+	}
+	return
+}
+
+// closes the constructed codec, if any
+func (z *Lazy[P, H]) Close() (e error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	if closer, ok := z.codec.(io.Closer); ok && z.built {
+		e = closer.Close()
+	}
+	return
+}
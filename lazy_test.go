@@ -0,0 +1,131 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazyCodec(t *testing.T) {
+	var built atomic.Int32
+	lazyJapanese := &Lazy[myLanguageContentType, myLanguageHubDataType]{
+		Id: "japanese",
+		Factory: func() (Codec[myLanguageContentType, myLanguageHubDataType], error) {
+			built.Add(1)
+			return fetchJapaneseCodec(), nil
+		},
+	}
+
+	translateLang, err := New(fetchEngCodec(), fetchSpanishCodec(), lazyJapanese)
+	assrtNil(t, err)
+	assrtFalse(t, lazyJapanese.Built())
+	assrtTrue(t, translateLang.Knows("japanese"))
+
+	// construction happens once, even with concurrent first use
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, tErr := translateLang.To("japanese", "english", "one two three")
+			assrtNil(t, tErr)
+			assrtEqual(t, myLanguageContentType("ichi ni san"), result)
+		}()
+	}
+	wg.Wait()
+	assrtTrue(t, lazyJapanese.Built())
+	assrtEqual(t, int32(1), built.Load())
+
+	result, tErr := translateLang.To("spanish", "japanese", "一 二 三")
+	assrtNil(t, tErr)
+	assrtEqual(t, myLanguageContentType("uno dos tres"), result)
+	assrtEqual(t, int32(1), built.Load())
+}
+
+func TestLazyCodecCheck(t *testing.T) {
+	var built int
+	lazy := &Lazy[string, string]{
+		Id: "lazy",
+		Factory: func() (Codec[string, string], error) {
+			built++
+			return testTaggingSpoke("anything", "a"), nil
+		},
+		Check: func(v string) bool { return v == "lazy" },
+	}
+
+	translate, err := New[string, string](lazy, testTaggingSpoke("eager", "a"))
+	assrtNil(t, err)
+	assrtEqual(t, []string{"eager"}, translate.Origins("a"))
+	assrtEqual(t, []string{"lazy"}, translate.Origins("lazy"))
+	assrtEqual(t, 0, built)
+
+	// the constructed codec is addressed by the name of the lazy codec
+	result, tErr := translate.Decode("lazy", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "anything(x)", result)
+	assrtEqual(t, 1, built)
+}
+
+func TestLazyCodecErrors(t *testing.T) {
+	tt := []struct {
+		cacheErrors   bool
+		expectedBuilt int
+	}{
+		{cacheErrors: false, expectedBuilt: 4}, // construction is retried by each call
+		{cacheErrors: true, expectedBuilt: 1},
+	}
+
+	for i, tx := range tt {
+		var built int
+		failure := fmt.Errorf("dictionary not found")
+		lazy := &Lazy[string, string]{
+			Id: "lazy",
+			Factory: func() (Codec[string, string], error) {
+				built++
+				return nil, failure
+			},
+			CacheErrors: tx.cacheErrors,
+		}
+		translate, err := New[string, string](lazy, testTaggingSpoke("eager", "a"))
+		assrtNil(t, err)
+
+		for j := 0; j < 3; j++ {
+			_, tErr := translate.To("eager", "lazy", "x")
+			assrtTrue(t, errors.Is(tErr, failure))
+			if j == 0 {
+				t.Logf("# %d: %v", i, tErr)
+			}
+		}
+		assrtEqual(t, []string{"eager"}, translate.Origins("a"))
+		assrtEqual(t, tx.expectedBuilt, built)
+		assrtFalse(t, lazy.Built())
+		if tx.cacheErrors {
+			assrtTrue(t, errors.Is(translate.Health()["lazy"], failure))
+		} else {
+			assrtNil(t, translate.Health()["lazy"])
+		}
+	}
+}
+
+func TestLazyCodecLifecycle(t *testing.T) {
+	var events []string
+	lazy := &Lazy[string, string]{
+		Id: "lazy",
+		Factory: func() (Codec[string, string], error) {
+			return newTestLifecycleSpoke("built", &events), nil
+		},
+	}
+	translate, err := New[string, string](lazy, testTaggingSpoke("eager"))
+	assrtNil(t, err)
+	assrtNil(t, translate.Close())
+	assrtEqual(t, 0, len(events))
+
+	translate, err = New[string, string](lazy, testTaggingSpoke("eager"))
+	assrtNil(t, err)
+	_, tErr := translate.Encode("lazy", "x")
+	assrtNil(t, tErr)
+	assrtNil(t, translate.Close())
+	assrtEqual(t, []string{"init built", "close built"}, events)
+}
@@ -0,0 +1,198 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// an optional interface for codecs, that need to acquire resources before use
+//   - Init is called once, when the codec is registered (ie. by New(..) or Register(..))
+type Initializer interface {
+	Init() (e error)
+}
+
+// an optional interface for codecs, that can report on their own status
+//   - Health returns a nil error, if the codec is fit for use
+type HealthChecker interface {
+	Health() (e error)
+}
+
+var (
+	// returned when a view of an Interpreter is asked to change the Interpreter
+	ErrReadOnly = errors.New("read-only interpreter view")
+	// returned when codecs are registered with a closed Interpreter
+	ErrClosed = errors.New("interpreter is closed")
+)
+
+var _ io.Closer = (Interpreter[int, int])(nil) //contract
+
+// returns bool true, if the Interpreter is a view of another Interpreter
+func (x *convertr[P, H]) isView() (r bool) {
+	r = len(x.scope) > 0 || x.allow != nil
+	return
+}
+
+func (x *convertr[P, H]) Register(codecs ...Codec[P, H]) (e error) {
+	if x.isView() {
+		e = ErrReadOnly
+		return
+	}
+	names := make(map[string]bool)
+	for _, c := range codecs {
+		if e = checkVersion(c); e != nil {
+			return
+		}
+		if !codecIsValid(c) {
+			var name string
+			if c != nil {
+				name = c.Name()
+			}
+			e = fmt.Errorf("invalid codec [ '%s' ]", name)
+			return
+		}
+		if names[c.Name()] {
+			e = fmt.Errorf("codec already registered [ '%s' ]", c.Name())
+			return
+		}
+		names[c.Name()] = true
+	}
+	e = x.register(codecs)
+	return
+}
+
+// registers the specified valid, uniquely named codecs, initializing each in turn
+//   - if a codec fails to initialize, the codecs already initialized by this call
+//     are closed (in reverse order), and none of the codecs are registered
+func (x *convertr[P, H]) register(codecs []Codec[P, H]) (e error) {
+	if e = x.checkRegistrable(codecs); e != nil {
+		return
+	}
+
+	// codecs are initialized without holding the lock, as Init(..) may be slow
+	for i, c := range codecs {
+		if initializer, ok := c.(Initializer); ok {
+			if err := initializer.Init(); err != nil {
+				e = errors.Join(fmt.Errorf("codec init failed [ '%s' ]: %w", c.Name(), err), closeCodecs(codecs[:i]))
+				return
+			}
+		}
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if e = x.checkRegistrableLocked(codecs); e != nil {
+		// lost a race with another registration, or with Close()
+		e = errors.Join(e, closeCodecs(codecs))
+		return
+	}
+	cdMap := make(codecMap[P, H], len(x.codecs)+len(codecs))
+	for name, c := range x.codecs {
+		cdMap[name] = c
+	}
+	for _, c := range codecs {
+		cdMap[c.Name()] = c
+		x.order = append(x.order, c.Name())
+	}
+	x.codecs = cdMap
+	return
+}
+
+func (x *convertr[P, H]) checkRegistrable(codecs []Codec[P, H]) (e error) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	e = x.checkRegistrableLocked(codecs)
+	return
+}
+
+func (x *convertr[P, H]) checkRegistrableLocked(codecs []Codec[P, H]) (e error) {
+	if x.closed {
+		e = ErrClosed
+		return
+	}
+	for i, c := range codecs {
+		if _, exists := x.codecs.getIf(c.Name()); exists {
+			e = fmt.Errorf("codec already registered [ '%s' ]", c.Name())
+			return
+		}
+		// a version may be registered under a single spelling only (eg. not both "kana@1" and "kana@1.0")
+		for _, name := range x.codecs.keys() {
+			if sameVersion(c.Name(), name) {
+				e = fmt.Errorf("codec version already registered [ '%s' as '%s' ]", c.Name(), name)
+				return
+			}
+		}
+		for _, other := range codecs[:i] {
+			if sameVersion(c.Name(), other.Name()) {
+				e = fmt.Errorf("codec version already registered [ '%s' as '%s' ]", c.Name(), other.Name())
+				return
+			}
+		}
+	}
+	return
+}
+
+func (x *convertr[P, H]) Close() (e error) {
+	if x.isView() {
+		e = ErrReadOnly
+		return
+	}
+	x.mu.Lock()
+	if x.closed {
+		x.mu.Unlock()
+		return
+	}
+	x.closed = true
+	codecs := make([]Codec[P, H], 0, len(x.order))
+	for _, name := range x.order {
+		codecs = append(codecs, x.codecs[name])
+	}
+	x.mu.Unlock()
+
+	e = closeCodecs(codecs)
+	return
+}
+
+// closes the specified codecs in reverse order, returning the joined errors
+func closeCodecs[P, H any](codecs []Codec[P, H]) (e error) {
+	var errs []error
+	for i := len(codecs) - 1; i >= 0; i-- {
+		if closer, ok := codecs[i].(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("codec close failed [ '%s' ]: %w", codecs[i].Name(), err))
+			}
+		}
+	}
+	e = errors.Join(errs...)
+	return
+}
+
+func (x *convertr[P, H]) Health() (r map[string]error) {
+	r = make(map[string]error)
+	for name, c := range x.registered() {
+		if !x.visible(name) {
+			continue
+		}
+		r[name[len(x.scope):]] = health(c)
+	}
+	return
+}
+
+// reports the status of the named codec (relative to the namespace of the view)
+//   - a codec that is not exposed by the view is reported with a nil error
+func (x *convertr[P, H]) codecHealth(name string) (e error) {
+	name = x.scope + name
+	codecs := x.registered()
+	if c, exists := codecs.getIf(name); exists && x.visible(name) {
+		e = health(c)
+	}
+	return
+}
+
+// returns the status of the specified codec, or nil if it does not implement HealthChecker
+func health[P, H any](c Codec[P, H]) (e error) {
+	if checker, ok := c.(HealthChecker); ok {
+		e = checker.Health()
+	}
+	return
+}
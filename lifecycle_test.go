@@ -0,0 +1,95 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// a codec that records its lifecycle events
+type testLifecycleSpoke struct {
+	Spoke[string, string]
+	events                       *[]string
+	initErr, healthErr, closeErr error
+}
+
+func newTestLifecycleSpoke(id string, events *[]string) (r *testLifecycleSpoke) {
+	r = &testLifecycleSpoke{Spoke: *testTaggingSpoke(id), events: events}
+	return
+}
+
+func (s *testLifecycleSpoke) Init() error {
+	*s.events = append(*s.events, "init "+s.Id)
+	return s.initErr
+}
+
+func (s *testLifecycleSpoke) Health() error {
+	return s.healthErr
+}
+
+func (s *testLifecycleSpoke) Close() error {
+	*s.events = append(*s.events, "close "+s.Id)
+	return s.closeErr
+}
+
+func TestLifecycle(t *testing.T) {
+	var events []string
+	a := newTestLifecycleSpoke("a", &events)
+	b := newTestLifecycleSpoke("b", &events)
+	c := newTestLifecycleSpoke("c", &events)
+	c.healthErr = fmt.Errorf("dictionary missing")
+
+	translate, err := New[string, string](a, testTaggingSpoke("plain"), b)
+	assrtNil(t, err)
+	assrtEqual(t, []string{"init a", "init b"}, events)
+
+	assrtNil(t, translate.Register(c))
+	assrtEqual(t, []string{"init a", "init b", "init c"}, events)
+	result, tErr := translate.To("c", "a", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "c(x)", result)
+
+	assrtEqual(t, map[string]error{"a": nil, "b": nil, "c": c.healthErr, "plain": nil}, translate.Health())
+	assrtEqual(t, map[string]error{"c": c.healthErr}, translate.Subset("c").Health())
+
+	assrtEqual(t, fmt.Errorf("codec already registered [ 'b' ]"), translate.Register(newTestLifecycleSpoke("b", &events)))
+	assrtEqual(t, fmt.Errorf("invalid codec [ '' ]"), translate.Register(&Spoke[string, string]{}))
+	assrtEqual(t, ErrReadOnly, translate.Subset("a").Register(newTestLifecycleSpoke("d", &events)))
+	assrtEqual(t, ErrReadOnly, translate.Namespace("ns").Close())
+	assrtEqual(t, []string{"init a", "init b", "init c"}, events)
+
+	// codecs are closed in reverse order of registration, and errors are aggregated
+	events = nil
+	a.closeErr = fmt.Errorf("a stuck")
+	c.closeErr = fmt.Errorf("c stuck")
+	err = translate.Close()
+	assrtEqual(t, []string{"close c", "close b", "close a"}, events)
+	assrtTrue(t, errors.Is(err, a.closeErr))
+	assrtTrue(t, errors.Is(err, c.closeErr))
+	t.Log(err)
+
+	assrtNil(t, translate.Close())
+	assrtEqual(t, ErrClosed, translate.Register(newTestLifecycleSpoke("d", &events)))
+}
+
+func TestLifecycleInitFailure(t *testing.T) {
+	var events []string
+	a := newTestLifecycleSpoke("a", &events)
+	b := newTestLifecycleSpoke("b", &events)
+	c := newTestLifecycleSpoke("c", &events)
+	c.initErr = fmt.Errorf("no such file")
+
+	_, err := New[string, string](a, b, c)
+	assrtTrue(t, errors.Is(err, c.initErr))
+	assrtEqual(t, []string{"init a", "init b", "init c", "close b", "close a"}, events)
+	t.Log(err)
+
+	// a failed registration leaves the Interpreter unchanged
+	events = nil
+	translate, err := New[string, string](a, b)
+	assrtNil(t, err)
+	d := newTestLifecycleSpoke("d", &events)
+	assrtTrue(t, errors.Is(translate.Register(d, c), c.initErr))
+	assrtFalse(t, translate.Knows("d"))
+	assrtEqual(t, []string{"init a", "init b", "init d", "init c", "close d"}, events)
+}
@@ -0,0 +1,134 @@
+package xl8r
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const redacted = "[redacted]"
+
+var discardLogger = slog.New(discardHandler{})
+
+// returns the request-scoped logger that an Interpreter passed along to a codec
+//   - the logger carries the origin, destination and stage of the translation
+//   - returns a logger that discards all records, if none was supplied
+func LoggerFrom(opts0 ...Opts) (r *slog.Logger) {
+	r = discardLogger
+	if len(opts0) > 0 {
+		if logger := opts0[0].logger; logger != nil {
+			r = logger
+		}
+	}
+	return
+}
+
+// a record of a single translation, used for logging
+type translation struct {
+	origin, destination string
+	stage               Stage
+	started             time.Time
+	content, result     any
+}
+
+func (x *convertr[P, H]) startTranslation(origin, destination string, stage Stage, content any) (r *translation) {
+	if x.cfg.Logger == nil {
+		return
+	}
+	r = &translation{
+		origin:      origin,
+		destination: destination,
+		stage:       stage,
+		started:     time.Now(),
+		content:     content,
+	}
+	return
+}
+
+// writes the log record for the specified translation
+//   - failures are logged at level WARN
+//   - successes are logged at level DEBUG
+func (x *convertr[P, H]) endTranslation(t *translation, e error) {
+	if t == nil {
+		return
+	}
+	level, msg := slog.LevelDebug, "translation"
+	if e != nil {
+		level, msg = slog.LevelWarn, "translation failed"
+	}
+	ctx := context.Background()
+	if !x.cfg.Logger.Enabled(ctx, level) {
+		return
+	}
+	attrs := t.attrs()
+	attrs = append(attrs, slog.Duration("duration", time.Since(t.started)))
+	if e != nil {
+		attrs = append(attrs, slog.String("error", e.Error()))
+	} else {
+		attrs = append(attrs, x.value("result", t.result))
+	}
+	attrs = append(attrs, x.value("content", t.content))
+	x.cfg.Logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// notes that the translation has reached the specified stage
+func (t *translation) reached(stage Stage) {
+	if t != nil {
+		t.stage = stage
+	}
+}
+
+// notes the result of the translation
+func (t *translation) finished(result any) {
+	if t != nil {
+		t.result = result
+	}
+}
+
+func (t *translation) attrs() (r []slog.Attr) {
+	if len(t.origin) > 0 {
+		r = append(r, slog.String("origin", t.origin))
+	}
+	if len(t.destination) > 0 {
+		r = append(r, slog.String("destination", t.destination))
+	}
+	r = append(r, slog.String("stage", string(t.stage)))
+	return
+}
+
+func (x *convertr[P, H]) value(key string, v any) (r slog.Attr) {
+	if x.cfg.LogContent {
+		r = slog.Any(key, v)
+	} else {
+		r = slog.String(key, redacted)
+	}
+	return
+}
+
+// returns the options to be passed along to a codec, during the specified translation
+//   - when logging is enabled, the options carry the request-scoped logger
+func (x *convertr[P, H]) scopedOpts(t *translation, opts0 []Opts) (r []Opts) {
+	if t == nil {
+		r = opts0
+		return
+	}
+	var opts Opts
+	if len(opts0) > 0 {
+		opts = opts0[0]
+	}
+	args := make([]any, 0, 6)
+	for _, a := range t.attrs() {
+		args = append(args, a)
+	}
+	opts.logger = x.cfg.Logger.With(args...)
+	r = append([]Opts{opts}, opts0[min(1, len(opts0)):]...)
+	return
+}
+
+// a slog.Handler that discards all records
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
@@ -0,0 +1,134 @@
+package xl8r
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// decodes the JSON log records written to the specified buffer
+func testLogRecords(t *testing.T, buf *bytes.Buffer) (r []map[string]any) {
+	t.Helper()
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		record := make(map[string]any)
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			failTest(t, "invalid log record %s -- %v", line, err)
+			continue
+		}
+		r = append(r, record)
+	}
+	return
+}
+
+func testLogger(buf *bytes.Buffer, level slog.Level) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: level}))
+}
+
+func TestLogFailedTranslations(t *testing.T) {
+	buf := &bytes.Buffer{}
+	translateLang, err := NewWithConfig(Config{Logger: testLogger(buf, slog.LevelWarn)}, definedLangTestCodecs...)
+	assrtNil(t, err)
+
+	_, tErr := translateLang.To("english", "spanish", "uno dos FooBar")
+	assrtNotNil(t, tErr)
+	_, tErr = translateLang.To("english", "spanish", "uno dos tres")
+	assrtNil(t, tErr)
+	_, tErr = translateLang.To("english", "latin", "unus")
+	assrtNotNil(t, tErr)
+
+	records := testLogRecords(t, buf)
+	assrtEqual(t, 2, len(records))
+	for i, record := range records {
+		assrtEqual(t, "WARN", record["level"])
+		assrtEqual(t, "english", record["destination"])
+		assrtEqual(t, "encode", record["stage"])
+		assrtEqual(t, redacted, record["content"])
+		assrtNotNil(t, record["duration"])
+		assrtNotNil(t, record["error"])
+		t.Logf("# %d: %v", i, record)
+	}
+	assrtEqual(t, "spanish", records[0]["origin"])
+	assrtEqual(t, "latin", records[1]["origin"])
+}
+
+func TestLogTranslations(t *testing.T) {
+	tt := []struct {
+		logContent              bool
+		expectContent, expected any
+	}{
+		{expectContent: redacted, expected: redacted},
+		{logContent: true, expectContent: "tres", expected: "three"},
+	}
+
+	for i, tx := range tt {
+		buf := &bytes.Buffer{}
+		cfg := Config{Logger: testLogger(buf, slog.LevelDebug), LogContent: tx.logContent}
+		translateLang, err := NewWithConfig(cfg, definedLangTestCodecs...)
+		assrtNil(t, err)
+
+		result, tErr := translateLang.To("english", "spanish", "tres")
+		assrtNil(t, tErr)
+		assrtEqual(t, myLanguageContentType("three"), result)
+
+		records := testLogRecords(t, buf)
+		assrtEqual(t, 1, len(records))
+		record := records[0]
+		assrtEqual(t, "DEBUG", record["level"])
+		assrtEqual(t, "spanish", record["origin"])
+		assrtEqual(t, "english", record["destination"])
+		assrtEqual(t, "decode", record["stage"])
+		assrtEqual(t, tx.expectContent, record["content"])
+		assrtEqual(t, tx.expected, record["result"])
+		assrtNil(t, record["error"])
+		t.Logf("# %d: %v", i, record)
+	}
+}
+
+func TestLoggerFrom(t *testing.T) {
+	spoke := func(id string) *Spoke[string, string] {
+		return &Spoke[string, string]{
+			Id: id,
+			Enc: func(v string, opts0 ...Opts) (r string, e error) {
+				LoggerFrom(opts0...).Info("encoding")
+				r = v
+				return
+			},
+			Dec: func(v string, opts0 ...Opts) (r string, e error) {
+				LoggerFrom(opts0...).Info("decoding")
+				r = v
+				return
+			},
+			Check: func(v string) bool { return true },
+		}
+	}
+
+	// without a configured logger, codecs receive a logger that discards records
+	assrtNotNil(t, LoggerFrom())
+	plain, err := New[string, string](spoke("a"), spoke("b"))
+	assrtNil(t, err)
+	_, tErr := plain.To("b", "a", "content")
+	assrtNil(t, tErr)
+
+	buf := &bytes.Buffer{}
+	logged, err := NewWithConfig[string, string](Config{Logger: testLogger(buf, slog.LevelInfo)}, spoke("a"), spoke("b"))
+	assrtNil(t, err)
+	userOpts := Opts{Dec: map[string]any{"k": "v"}}
+	_, tErr = logged.To("b", "a", "content", userOpts)
+	assrtNil(t, tErr)
+
+	records := testLogRecords(t, buf)
+	assrtEqual(t, 2, len(records))
+	assrtEqual(t, "encoding", records[0]["msg"])
+	assrtEqual(t, "encode", records[0]["stage"])
+	assrtEqual(t, "decoding", records[1]["msg"])
+	assrtEqual(t, "decode", records[1]["stage"])
+	for _, record := range records {
+		assrtEqual(t, "a", record["origin"])
+		assrtEqual(t, "b", record["destination"])
+	}
+}
@@ -0,0 +1,72 @@
+package xl8r
+
+import (
+	"sort"
+	"strings"
+)
+
+// separates the namespaces within a codec name (eg. "lang/ja/kana")
+const namespaceSep = "/"
+
+// returns the prefix shared by all codec names within the specified namespace
+//   - returns an empty string, for the root namespace
+func namespacePrefix(namespace string) (r string) {
+	if ns := strings.Trim(namespace, namespaceSep); len(ns) > 0 {
+		r = ns + namespaceSep
+	}
+	return
+}
+
+func (x *convertr[P, H]) Namespace(namespace string) (r Interpreter[P, H]) {
+	prefix := namespacePrefix(namespace)
+	if len(prefix) == 0 {
+		r = x
+		return
+	}
+	view := *x
+	view.scope = x.scope + prefix
+	r = &view
+	return
+}
+
+func (x *convertr[P, H]) List(namespace string) (r []string) {
+	prefix := x.scope + namespacePrefix(namespace)
+	codecs := x.registered()
+	for _, name := range codecs.keys() {
+		if strings.HasPrefix(name, prefix) && x.visible(name) {
+			r = append(r, strings.TrimPrefix(name, x.scope))
+		}
+	}
+	sort.Strings(r)
+	return
+}
+
+func (x *convertr[P, H]) OriginsIn(namespace string, content0 ...P) (r []string) {
+	r = x.origins(x.scope+namespacePrefix(namespace), content0)
+	return
+}
+
+// returns the names of the codecs with the specified prefix,
+// whose encoder can process any of the specified content
+//   - with no content specified, all codecs with the prefix are returned
+//   - names are returned relative to the namespace of the view
+func (x *convertr[P, H]) origins(prefix string, content0 []P) (r []string) {
+	for name, origin := range x.registered() {
+		if !strings.HasPrefix(name, prefix) || !x.visible(name) {
+			continue
+		}
+		matches := len(content0) == 0
+		for _, content := range content0 {
+			if matches = x.evaluate(name, origin, content); matches {
+				break
+			}
+		}
+		if matches {
+			r = append(r, strings.TrimPrefix(name, x.scope))
+		}
+	}
+	if !x.cfg.AllVersions {
+		r = latestVersions(r)
+	}
+	return
+}
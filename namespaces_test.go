@@ -0,0 +1,90 @@
+package xl8r
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+func testNamespacedInterpreter(t *testing.T) Interpreter[string, string] {
+	t.Helper()
+	translate, err := New[string, string](
+		testTaggingSpoke("lang/ja/kana", "a"),
+		testTaggingSpoke("lang/ja/kanji@1", "a"),
+		testTaggingSpoke("lang/ja/kanji@2", "a"),
+		testTaggingSpoke("lang/es", "a"),
+		testTaggingSpoke("lang/jam", "a"),
+		testTaggingSpoke("num/roman", "a"),
+	)
+	assrtNil(t, err)
+	return translate
+}
+
+func TestNamespaceList(t *testing.T) {
+	translate := testNamespacedInterpreter(t)
+
+	tt := []struct {
+		namespace string
+		expected  []string
+	}{
+		{namespace: "", expected: []string{"lang/es", "lang/ja/kana", "lang/ja/kanji@1", "lang/ja/kanji@2", "lang/jam", "num/roman"}},
+		{namespace: "lang", expected: []string{"lang/es", "lang/ja/kana", "lang/ja/kanji@1", "lang/ja/kanji@2", "lang/jam"}},
+		{namespace: "lang/ja", expected: []string{"lang/ja/kana", "lang/ja/kanji@1", "lang/ja/kanji@2"}},
+		{namespace: "/lang/ja/", expected: []string{"lang/ja/kana", "lang/ja/kanji@1", "lang/ja/kanji@2"}},
+		{namespace: "num", expected: []string{"num/roman"}},
+		{namespace: "lang/ko"},
+	}
+
+	for i, tx := range tt {
+		names := translate.List(tx.namespace)
+		assrtEqual(t, tx.expected, names)
+		t.Logf(`# %d: List("%s") -- %v`, i, tx.namespace, names)
+	}
+}
+
+func TestNamespaceOrigins(t *testing.T) {
+	translate := testNamespacedInterpreter(t)
+
+	origins := translate.OriginsIn("lang/ja", "a")
+	sort.Strings(origins)
+	assrtEqual(t, []string{"lang/ja/kana", "lang/ja/kanji@2"}, origins)
+
+	origins = translate.OriginsIn("num", "a")
+	assrtEqual(t, []string{"num/roman"}, origins)
+	assrtEqual(t, 0, len(translate.OriginsIn("num", "z")))
+	assrtEqual(t, 5, len(translate.OriginsIn("", "a")))
+}
+
+func TestNamespaceView(t *testing.T) {
+	translate := testNamespacedInterpreter(t)
+	ja := translate.Namespace("lang/ja")
+
+	origins := ja.Origins("a")
+	sort.Strings(origins)
+	assrtEqual(t, []string{"kana", "kanji@2"}, origins)
+	assrtEqual(t, []string{"kana", "kanji@1", "kanji@2"}, ja.List(""))
+	assrtEqual(t, []string{"kanji@1", "kanji@2"}, ja.Versions("kanji"))
+
+	assrtTrue(t, ja.Knows("kana"))
+	assrtTrue(t, ja.Knows("kanji@latest"))
+	assrtFalse(t, ja.Knows("lang/ja/kana"))
+	assrtFalse(t, ja.Knows("es"))
+
+	result, err := ja.To("kanji", "kana", "x")
+	assrtNil(t, err)
+	assrtEqual(t, "lang/ja/kanji@2(x)", result)
+
+	_, err = ja.To("es", "kana", "x")
+	assrtEqual(t, fmt.Errorf(`no decoder [ 'es'<- ]`), err)
+
+	// views may be scoped further, and share the codecs of the Interpreter
+	lang := translate.Namespace("lang")
+	assrtEqual(t, []string{"kana", "kanji@1", "kanji@2"}, lang.Namespace("ja").List(""))
+	assrtEqual(t, []string{"ja/kana", "ja/kanji@1", "ja/kanji@2"}, lang.List("ja"))
+	hub, err := lang.Encode("ja/kana", "x")
+	assrtNil(t, err)
+	result, err = lang.Decode("es", hub)
+	assrtNil(t, err)
+	assrtEqual(t, "lang/es(x)", result)
+	assrtTrue(t, translate.Namespace("") == translate)
+}
@@ -0,0 +1,254 @@
+package numerals
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the multipliers within a group of 4 digits, from the largest
+var cjkPlaces = []int64{1000, 100, 10, 1}
+
+// a numeral system of Chinese characters, with groups of 4 digits (ie. 万 grouping)
+type cjkSystem struct {
+	id string
+	// the digits zero through nine, and the multipliers 十, 百 and 千
+	digits, mults []string
+	// the formal (ie. financial) digits and multipliers
+	formalDigits, formalMults []string
+	// the scales of groups (eg. 万, 億), from the ones
+	scales []string
+	minus  string
+	// when bool true, zeros are written within numbers (eg. 一千零五)
+	zeros bool
+	// returns bool true, if the digit one is omitted before the multiplier of the place
+	//   - leading, if the place is the first of the number
+	//   - bare, if the number is below 10000
+	omitOne func(place int64, leading, bare bool) bool
+	// the variants of characters, accepted when encoding
+	variants *strings.Replacer
+	// replaces formal characters, with their informal equivalents
+	informal *strings.Replacer
+}
+
+// returns the (exclusive) limit of numbers
+func (c *cjkSystem) limit() (r *big.Int) {
+	r = new(big.Int).Exp(big.NewInt(10000), big.NewInt(int64(len(c.scales))), nil)
+	return
+}
+
+// writes the specified group of 4 digits
+func (c *cjkSystem) group(g int64, leading, bare, formal bool) (r string) {
+	digits, mults := c.digits, c.mults
+	if formal {
+		digits, mults = c.formalDigits, c.formalMults
+	}
+	var sb strings.Builder
+	zero, emitted := false, false
+	for i, place := range cjkPlaces {
+		d := g / place % 10
+		if d == 0 {
+			zero = zero || emitted
+			continue
+		}
+		if zero && c.zeros {
+			sb.WriteString(digits[0])
+		}
+		switch {
+		case place == 1:
+			sb.WriteString(digits[d])
+		case d == 1 && !formal && c.omitOne(place, leading && !emitted, bare):
+			sb.WriteString(mults[i])
+		default:
+			sb.WriteString(digits[d] + mults[i])
+		}
+		zero, emitted = false, true
+	}
+	r = sb.String()
+	return
+}
+
+// writes the specified non-negative number
+func (c *cjkSystem) format(n *big.Int, formal bool) (r string) {
+	if n.Sign() == 0 {
+		if r = c.digits[0]; formal {
+			r = c.formalDigits[0]
+		}
+		return
+	}
+	var groups []int64
+	rest, base, mod := new(big.Int).Set(n), big.NewInt(10000), new(big.Int)
+	for rest.Sign() > 0 {
+		rest.DivMod(rest, base, mod)
+		groups = append(groups, mod.Int64())
+	}
+	var sb strings.Builder
+	started, zero := false, false
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		if g == 0 {
+			zero = zero || started
+			continue
+		}
+		if (zero || (started && g < 1000)) && c.zeros {
+			sb.WriteString(c.digits[0])
+		}
+		sb.WriteString(c.group(g, !started, len(groups) == 1, formal) + c.scales[i])
+		started, zero = true, false
+	}
+	r = sb.String()
+	return
+}
+
+// reads the specified number (without validating its form)
+func (c *cjkSystem) parse(s string) (r *big.Int, e error) {
+	values := make(map[string]int64)
+	for i, digit := range c.digits {
+		values[digit] = int64(i)
+	}
+	multValues := make(map[string]int64)
+	for i, mult := range c.mults {
+		multValues[mult] = cjkPlaces[i]
+	}
+	scaleValues := make(map[string]*big.Int)
+	scale := big.NewInt(1)
+	for _, name := range c.scales[1:] {
+		scale = new(big.Int).Mul(scale, big.NewInt(10000))
+		scaleValues[name] = scale
+	}
+
+	var segments []*big.Int
+	var group, pending int64
+	for _, ch := range s {
+		text := string(ch)
+		if d, exists := values[text]; exists {
+			if pending > 0 {
+				e = fmt.Errorf("unexpected digit '%s'", text)
+				return
+			}
+			pending = d
+		} else if m, exists := multValues[text]; exists {
+			group += max(pending, 1) * m
+			pending = 0
+		} else if scale, exists := scaleValues[text]; exists {
+			m := big.NewInt(group + pending)
+			for len(segments) > 0 && segments[len(segments)-1].Cmp(scale) < 0 {
+				m.Add(m, segments[len(segments)-1])
+				segments = segments[:len(segments)-1]
+			}
+			segments = append(segments, m.Mul(m, scale))
+			group, pending = 0, 0
+		} else {
+			e = fmt.Errorf("invalid numeral '%s'", text)
+			return
+		}
+	}
+	r = big.NewInt(group + pending)
+	for _, segment := range segments {
+		r.Add(r, segment)
+	}
+	return
+}
+
+func (c *cjkSystem) encode(v string, _ ...xl8r.Opts) (r *big.Int, e error) {
+	s := c.variants.Replace(v)
+	negative := strings.HasPrefix(s, c.minus)
+	s = strings.TrimPrefix(s, c.minus)
+	if len(s) == 0 {
+		e = fmt.Errorf("no numerals [ %s ]", v)
+		return
+	}
+	if r, e = c.parse(c.informal.Replace(s)); e != nil {
+		r, e = nil, fmt.Errorf("invalid numerals [ %s ]: %w", v, e)
+		return
+	}
+	// only the canonical form is accepted, either formal or not
+	canonical := c.variants.Replace(c.format(r, false))
+	formal := c.variants.Replace(c.format(r, true))
+	if (s != canonical && s != formal) || r.Cmp(c.limit()) >= 0 || (negative && r.Sign() == 0) {
+		r, e = nil, fmt.Errorf("not a canonical numeral [ %s ]", v)
+		return
+	}
+	if negative {
+		r.Neg(r)
+	}
+	return
+}
+
+func (c *cjkSystem) decode(v *big.Int, opts0 ...xl8r.Opts) (r string, e error) {
+	var o decodeOptions
+	if o, e = readOptions(v, opts0); e != nil {
+		return
+	}
+	if new(big.Int).Abs(v).Cmp(c.limit()) >= 0 {
+		e = fmt.Errorf("number too large [ %v ]", v)
+		return
+	}
+	if r = c.format(new(big.Int).Abs(v), o.formal); v.Sign() < 0 {
+		r = c.minus + r
+	}
+	return
+}
+
+func (c *cjkSystem) codec() (r *xl8r.Spoke[string, *big.Int]) {
+	var pairs []string
+	for i, digit := range c.formalDigits {
+		pairs = append(pairs, digit, c.digits[i])
+	}
+	for i, mult := range c.formalMults {
+		pairs = append(pairs, mult, c.mults[i])
+	}
+	c.informal = strings.NewReplacer(pairs...)
+	r = &xl8r.Spoke[string, *big.Int]{
+		Id:  c.id,
+		Enc: c.encode,
+		Dec: c.decode,
+		Check: func(v string) (r bool) {
+			_, err := c.encode(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns the codec for Chinese numerals, below 10^16 (eg. "一万零五", "负十二")
+//   - formal numerals (eg. "壹万零伍") and traditional characters (eg. "萬", "億") are accepted
+func Chinese() (r *xl8r.Spoke[string, *big.Int]) {
+	r = (&cjkSystem{
+		id:           "chinese",
+		digits:       []string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"},
+		mults:        []string{"千", "百", "十"},
+		formalDigits: []string{"零", "壹", "贰", "叁", "肆", "伍", "陆", "柒", "捌", "玖"},
+		formalMults:  []string{"仟", "佰", "拾"},
+		scales:       []string{"", "万", "亿", "万亿"},
+		minus:        "负",
+		zeros:        true,
+		omitOne: func(place int64, leading, _ bool) bool {
+			return place == 10 && leading
+		},
+		variants: strings.NewReplacer("萬", "万", "億", "亿", "〇", "零", "貳", "贰", "參", "叁", "陸", "陆", "負", "负"),
+	}).codec()
+	return
+}
+
+// returns the codec for Japanese numerals, below 10^20 (eg. "一万五", "マイナス十二")
+//   - formal numerals (ie. daiji, eg. "壱万五") are accepted
+func Japanese() (r *xl8r.Spoke[string, *big.Int]) {
+	r = (&cjkSystem{
+		id:           "japanese",
+		digits:       []string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"},
+		mults:        []string{"千", "百", "十"},
+		formalDigits: []string{"〇", "壱", "弐", "参", "四", "五", "六", "七", "八", "九"},
+		formalMults:  []string{"千", "百", "拾"},
+		scales:       []string{"", "万", "億", "兆", "京"},
+		minus:        "マイナス",
+		omitOne: func(place int64, _, bare bool) bool {
+			return place < 1000 || bare
+		},
+		variants: strings.NewReplacer("零", "〇", "萬", "万"),
+	}).codec()
+	return
+}
@@ -0,0 +1,147 @@
+/*
+Package numerals provides codecs for integers written in numeral systems (eg. Roman numerals, Chinese numerals
+or Devanagari digits), with *big.Int hub data.
+
+	convertNumeral, err := numerals.New(xl8r.Config{})
+	roman, err := convertNumeral.To("roman", "devanagari", "२०२४")	// "MMXXIV"
+
+Encoding is strict: only the canonical form of a number is accepted (eg. "IV", but not "IIII").
+
+Decoding may be tuned with these xl8r.Opts Dec keys:
+  - OptCase (Case): the letter case of Roman numerals (by default, CaseUpper)
+  - OptGlyphs (bool): when true, apostrophus numerals are written with single glyphs (eg. "ↀ", rather than "CIↃ")
+  - OptFormal (bool): when true, Chinese and Japanese numerals are written with formal (ie. financial) characters
+  - OptGrouping (Grouping): the grouping of digits (by default, GroupNone)
+
+The codecs are also registered under the "numerals" domain (see xl8r.NewFromDomain).
+*/
+package numerals
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "numerals"
+
+// the xl8r.Opts Dec keys, read by the decoders of this package
+const (
+	OptCase     = "case"
+	OptGlyphs   = "glyphs"
+	OptFormal   = "formal"
+	OptGrouping = "grouping"
+)
+
+// the letter case of Roman numerals
+type Case string
+
+const (
+	// eg. "XIV"
+	CaseUpper Case = "upper"
+	// eg. "xiv"
+	CaseLower Case = "lower"
+)
+
+// the grouping of digits
+type Grouping string
+
+const (
+	// eg. "1234567"
+	GroupNone Grouping = "none"
+	// eg. "1,234,567"
+	GroupThousands Grouping = "thousands"
+	// eg. "12,34,567" (ie. lakh and crore)
+	GroupIndian Grouping = "indian"
+)
+
+// the options of a single decoding
+type decodeOptions struct {
+	letterCase Case
+	glyphs     bool
+	formal     bool
+	grouping   Grouping
+}
+
+// returns the options of decoding the specified hub data
+func readOptions(v *big.Int, opts0 []xl8r.Opts) (r decodeOptions, e error) {
+	r = decodeOptions{letterCase: CaseUpper, grouping: GroupNone}
+	if v == nil {
+		e = fmt.Errorf("nil number")
+		return
+	}
+	if len(opts0) == 0 {
+		return
+	}
+	decoderOpts := opts0[0].Dec
+	if xValue, exists := decoderOpts[OptCase]; exists {
+		letterCase, ok := xValue.(Case)
+		if !ok || (letterCase != CaseUpper && letterCase != CaseLower) {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptCase, xValue)
+			return
+		}
+		r.letterCase = letterCase
+	}
+	for key, flag := range map[string]*bool{OptGlyphs: &r.glyphs, OptFormal: &r.formal} {
+		if xValue, exists := decoderOpts[key]; exists {
+			value, ok := xValue.(bool)
+			if !ok {
+				e = fmt.Errorf("invalid option [ %s ]: %v", key, xValue)
+				return
+			}
+			*flag = value
+		}
+	}
+	if xValue, exists := decoderOpts[OptGrouping]; exists {
+		grouping, ok := xValue.(Grouping)
+		if !ok || (grouping != GroupNone && grouping != GroupThousands && grouping != GroupIndian) {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptGrouping, xValue)
+			return
+		}
+		r.grouping = grouping
+	}
+	return
+}
+
+// builds the specified codec, and panics on error (for the codecs of this package)
+func mustBuild(d *Digits) (r *xl8r.Spoke[string, *big.Int]) {
+	var err error
+	if r, err = d.Build(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// returns the codecs of this package
+//   - positional digits: "arabic" (eg. "2024"), "devanagari" (eg. "२०२४"), "arabic-indic" (eg. "٢٠٢٤"),
+//     "thai" (eg. "๒๐๒๔") and "fullwidth" (eg. "２０２４")
+//   - "roman" (eg. "MMXXIV") and "roman-apostrophus" (eg. "CCIↃↃ" for 10000)
+//   - "chinese" (eg. "二千零二十四") and "japanese" (eg. "二千二十四")
+func Codecs() (r []xl8r.Codec[string, *big.Int]) {
+	r = []xl8r.Codec[string, *big.Int]{
+		mustBuild(&Digits{Id: "arabic", Zero: '0'}),
+		mustBuild(&Digits{Id: "devanagari", Zero: '०'}),
+		mustBuild(&Digits{Id: "arabic-indic", Zero: '٠', GroupSep: "٬"}),
+		mustBuild(&Digits{Id: "thai", Zero: '๐'}),
+		mustBuild(&Digits{Id: "fullwidth", Zero: '０', Minus: "－", GroupSep: "，"}),
+		Roman(),
+		Apostrophus(),
+		Chinese(),
+		Japanese(),
+	}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, *big.Int], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
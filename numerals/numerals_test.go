@@ -0,0 +1,163 @@
+package numerals
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestNumerals(t *testing.T) {
+	convertNumeral, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "roman", from: "devanagari", text: "२०२४", expected: "MMXXIV"},
+		{to: "arabic", from: "roman", text: "mcmxcix", expected: "1999"},
+		{to: "thai", from: "roman", text: "MMMCMXCIX", expected: "๓๙๙๙"},
+		{to: "roman-apostrophus", from: "arabic", text: "1666", expected: "CIↃDCLXVI"},
+		{to: "roman-apostrophus", from: "arabic", text: "4000", expected: "CIↃIↃↃ"},
+		{to: "arabic", from: "roman-apostrophus", text: "CCCIↃↃↃCCIↃↃ", expected: "110000"},
+		{to: "arabic", from: "roman-apostrophus", text: "ↈↂ", expected: "110000"},
+		{to: "arabic-indic", from: "arabic", text: "-1,234,567", expected: "-١٢٣٤٥٦٧"},
+		{to: "fullwidth", from: "arabic", text: "-12,34,567", expected: "－１２３４５６７"},
+		{to: "arabic", from: "fullwidth", text: "－１２３", expected: "-123"},
+		{to: "arabic", from: "fullwidth", text: "-１２３", expected: "-123"},
+		{to: "chinese", from: "arabic", text: "10005", expected: "一万零五"},
+		{to: "chinese", from: "arabic", text: "1050", expected: "一千零五十"},
+		{to: "chinese", from: "arabic", text: "15", expected: "十五"},
+		{to: "chinese", from: "arabic", text: "110", expected: "一百一十"},
+		{to: "chinese", from: "arabic", text: "100010000", expected: "一亿零一万"},
+		{to: "chinese", from: "arabic", text: "3000000000000", expected: "三万亿"},
+		{to: "chinese", from: "arabic", text: "-12", expected: "负十二"},
+		{to: "arabic", from: "chinese", text: "一億二千萬", expected: "120000000"},
+		{to: "arabic", from: "chinese", text: "壹仟零伍", expected: "1005"},
+		{to: "japanese", from: "arabic", text: "10005", expected: "一万五"},
+		{to: "japanese", from: "arabic", text: "21000", expected: "二万一千"},
+		{to: "japanese", from: "arabic", text: "1000", expected: "千"},
+		{to: "japanese", from: "arabic", text: "0", expected: "〇"},
+		{to: "arabic", from: "japanese", text: "一億二千万", expected: "120000000"},
+		{to: "arabic", from: "japanese", text: "壱万弐千", expected: "12000"},
+		{to: "arabic", from: "japanese", text: "マイナス三", expected: "-3"},
+		// encoding is strict
+		{to: "arabic", from: "roman", text: "IIII", expectedErr: true},
+		{to: "arabic", from: "roman", text: "IM", expectedErr: true},
+		{to: "arabic", from: "roman", text: "MMMM", expectedErr: true},
+		{to: "arabic", from: "roman", text: "Xiv", expectedErr: true},
+		{to: "arabic", from: "roman-apostrophus", text: "CIↃↀ", expectedErr: true},
+		{to: "arabic", from: "arabic", text: "007", expectedErr: true},
+		{to: "arabic", from: "arabic", text: "-0", expectedErr: true},
+		{to: "arabic", from: "arabic", text: "12,34", expectedErr: true},
+		{to: "arabic", from: "arabic", text: "1,2345", expectedErr: true},
+		{to: "arabic", from: "devanagari", text: "२0२४", expectedErr: true},
+		{to: "arabic", from: "chinese", text: "一千五", expectedErr: true},
+		{to: "arabic", from: "chinese", text: "一十五", expectedErr: true},
+		{to: "arabic", from: "japanese", text: "一千零五", expectedErr: true},
+		{to: "arabic", from: "japanese", text: "二二", expectedErr: true},
+		// out of range
+		{to: "roman", from: "arabic", text: "0", expectedErr: true},
+		{to: "roman", from: "arabic", text: "4000", expectedErr: true},
+		{to: "chinese", from: "arabic", text: "10000000000000000", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertNumeral.To(tx.to, tx.from, tx.text)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s") ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestNumeralOptions(t *testing.T) {
+	convertNumeral, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := func(kv ...any) xl8r.Opts {
+		dec := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			dec[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Dec: dec}
+	}
+
+	tt := []struct {
+		to, text, expected string
+		opts               xl8r.Opts
+		expectedErr        bool
+	}{
+		{to: "roman", text: "14", opts: opts(OptCase, CaseLower), expected: "xiv"},
+		{to: "roman-apostrophus", text: "5000", opts: opts(OptCase, CaseLower), expected: "iↄↄ"},
+		{to: "roman-apostrophus", text: "16000", opts: opts(OptGlyphs, true), expected: "ↂↁↀ"},
+		{to: "chinese", text: "1234", opts: opts(OptFormal, true), expected: "壹仟贰佰叁拾肆"},
+		{to: "chinese", text: "10", opts: opts(OptFormal, true), expected: "壹拾"},
+		{to: "japanese", text: "12000", opts: opts(OptFormal, true), expected: "壱万弐千"},
+		{to: "arabic", text: "1234567", opts: opts(OptGrouping, GroupThousands), expected: "1,234,567"},
+		{to: "arabic", text: "1234567", opts: opts(OptGrouping, GroupIndian), expected: "12,34,567"},
+		{to: "arabic", text: "-123", opts: opts(OptGrouping, GroupIndian), expected: "-123"},
+		{to: "arabic-indic", text: "1234", opts: opts(OptGrouping, GroupThousands), expected: "١٬٢٣٤"},
+		{to: "roman", text: "14", opts: opts(OptCase, "title"), expectedErr: true},
+		{to: "chinese", text: "14", opts: opts(OptFormal, "yes"), expectedErr: true},
+		{to: "arabic", text: "14", opts: opts(OptGrouping, 3), expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertNumeral.To(tx.to, "arabic", tx.text, tx.opts)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","arabic","%s",%v) ==>> "%s" %v, expected "%s"`, i, tx.to, tx.text, tx.opts.Dec, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestNumeralRoundTrips(t *testing.T) {
+	// numbers in range have exactly one canonical form, which is read back
+	apostrophus := Apostrophus()
+	glyphs := xl8r.Opts{Dec: map[string]any{OptGlyphs: true}}
+	for n := int64(1); n <= 399999; n += 1 + n/500 {
+		for _, opts := range []xl8r.Opts{{}, glyphs} {
+			text, dErr := apostrophus.Decode(big.NewInt(n), opts)
+			if hub, eErr := apostrophus.Encode(text); dErr != nil || eErr != nil || hub.Int64() != n {
+				t.Fatalf(`%d ==>> "%s" ==>> %v %v %v`, n, text, hub, dErr, eErr)
+			}
+		}
+	}
+
+	numbers := []string{"0", "1", "10", "11", "20", "100", "101", "110", "1000", "1001", "1010", "10000", "10001",
+		"100000", "1000000", "10000000", "10001000", "10000100", "100000000", "100000001", "987654321012345"}
+	for _, c := range []xl8r.Codec[string, *big.Int]{Chinese(), Japanese()} {
+		for _, number := range numbers {
+			n, _ := new(big.Int).SetString(number, 10)
+			for _, formal := range []bool{false, true} {
+				text, dErr := c.Decode(n, xl8r.Opts{Dec: map[string]any{OptFormal: formal}})
+				if hub, eErr := c.Encode(text); dErr != nil || eErr != nil || hub.Cmp(n) != 0 {
+					t.Errorf(`%s: %s ==>> "%s" ==>> %v %v %v`, c.Name(), number, text, hub, dErr, eErr)
+				}
+			}
+		}
+	}
+}
+
+func TestDigitsBuild(t *testing.T) {
+	persian, err := (&Digits{Id: "persian", Zero: '۰', GroupSep: "٬"}).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hub, eErr := persian.Encode("۱٬۴۰۳"); eErr != nil || hub.Int64() != 1403 {
+		t.Errorf(`Encode("۱٬۴۰۳") ==>> %v %v`, hub, eErr)
+	}
+	for i, d := range []*Digits{{Zero: '0'}, {Id: "d", Zero: 'a'}, {Id: "d", Zero: '5'}} {
+		if _, err := d.Build(); err == nil {
+			t.Errorf("# %d: expected an error", i)
+		}
+	}
+
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+}
@@ -0,0 +1,165 @@
+package numerals
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// a builder of codecs, for integers written with the decimal digits of a script (eg. Devanagari)
+//   - encoding accepts digits grouped by thousands (eg. "1,234,567") or the Indian way (eg. "12,34,567"),
+//     but no leading zeros
+type Digits struct {
+	// name of the codec
+	Id string
+	// the digit zero of the script (eg. '०'), followed by the digits one through nine
+	Zero rune
+	// the minus sign, produced by decoding (by default, "-")
+	//   - "-" is always accepted, when encoding
+	Minus string
+	// the separator of digit groups (by default, ",")
+	GroupSep string
+}
+
+// builds the codec
+//   - returns an error for an invalid name, or digit zero
+func (d *Digits) Build() (r *xl8r.Spoke[string, *big.Int], e error) {
+	var errs []error
+	if len(d.Id) == 0 {
+		errs = append(errs, fmt.Errorf("empty codec name"))
+	}
+	for i := rune(0); i < 10; i++ {
+		if !unicode.IsDigit(d.Zero + i) {
+			errs = append(errs, fmt.Errorf("not a run of ten digits, from '%c'", d.Zero))
+			break
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		e = fmt.Errorf("digits codec [ '%s' ]: %w", d.Id, err)
+		return
+	}
+
+	p := *d
+	if len(p.Minus) == 0 {
+		p.Minus = "-"
+	}
+	if len(p.GroupSep) == 0 {
+		p.GroupSep = ","
+	}
+	r = &xl8r.Spoke[string, *big.Int]{
+		Id:  p.Id,
+		Enc: p.encode,
+		Dec: p.decode,
+		Check: func(v string) (r bool) {
+			_, err := p.encode(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns bool true, if the specified groups of digits are grouped by thousands, or the Indian way
+func validGrouping(groups []string) (r bool) {
+	last := len(groups) - 1
+	thousands, indian := true, utf8.RuneCountInString(groups[last]) == 3
+	for i, group := range groups {
+		switch size := utf8.RuneCountInString(group); {
+		case i == 0:
+			thousands = thousands && size >= 1 && size <= 3
+			indian = indian && size >= 1 && size <= 2
+		case i < last:
+			thousands = thousands && size == 3
+			indian = indian && size == 2
+		default:
+			thousands = thousands && size == 3
+		}
+	}
+	r = thousands || indian
+	return
+}
+
+func (d *Digits) encode(v string, _ ...xl8r.Opts) (r *big.Int, e error) {
+	s := strings.TrimSpace(v)
+	negative := false
+	for _, minus := range []string{d.Minus, "-"} {
+		if strings.HasPrefix(s, minus) {
+			s, negative = strings.TrimPrefix(s, minus), true
+			break
+		}
+	}
+	if groups := strings.Split(s, d.GroupSep); len(groups) > 1 {
+		if !validGrouping(groups) {
+			e = fmt.Errorf("invalid grouping of digits [ %s ]", v)
+			return
+		}
+		s = strings.Join(groups, "")
+	}
+	var sb strings.Builder
+	for _, c := range s {
+		if c < d.Zero || c > d.Zero+9 {
+			e = fmt.Errorf("invalid digit '%c', in [ %s ]", c, v)
+			return
+		}
+		sb.WriteByte(byte('0' + c - d.Zero))
+	}
+	digits := sb.String()
+	switch {
+	case len(digits) == 0:
+		e = fmt.Errorf("no digits [ %s ]", v)
+	case len(digits) > 1 && digits[0] == '0':
+		e = fmt.Errorf("leading zero, in [ %s ]", v)
+	case negative && digits == "0":
+		e = fmt.Errorf("negative zero [ %s ]", v)
+	default:
+		r, _ = new(big.Int).SetString(digits, 10)
+		if negative {
+			r.Neg(r)
+		}
+	}
+	return
+}
+
+func (d *Digits) decode(v *big.Int, opts0 ...xl8r.Opts) (r string, e error) {
+	var o decodeOptions
+	if o, e = readOptions(v, opts0); e != nil {
+		return
+	}
+	digits := new(big.Int).Abs(v).String()
+
+	// the sizes of groups, from the right
+	var sizes []int
+	switch o.grouping {
+	case GroupThousands:
+		sizes = []int{3, 3}
+	case GroupIndian:
+		sizes = []int{3, 2}
+	}
+	var groups []string
+	for i := 0; len(sizes) > 0 && len(digits) > sizes[min(i, 1)]; i++ {
+		size := sizes[min(i, 1)]
+		groups = append([]string{digits[len(digits)-size:]}, groups...)
+		digits = digits[:len(digits)-size]
+	}
+	groups = append([]string{digits}, groups...)
+
+	var sb strings.Builder
+	if v.Sign() < 0 {
+		sb.WriteString(d.Minus)
+	}
+	for i, group := range groups {
+		if i > 0 {
+			sb.WriteString(d.GroupSep)
+		}
+		for _, c := range group {
+			sb.WriteRune(d.Zero + c - '0')
+		}
+	}
+	r = sb.String()
+	return
+}
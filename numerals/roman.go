@@ -0,0 +1,150 @@
+package numerals
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the numerals of one decimal place: one, five and ten (empty, if not available)
+type romanPlace [3]string
+
+// the places of standard Roman numerals, from the ones
+var romanPlaces = []romanPlace{{"I", "V", "X"}, {"X", "L", "C"}, {"C", "D", "M"}, {"M", "", ""}}
+
+// the places of apostrophus numerals, from the ones
+var apostrophusPlaces = []romanPlace{
+	{"I", "V", "X"}, {"X", "L", "C"}, {"C", "D", "CIↃ"}, {"CIↃ", "IↃↃ", "CCIↃↃ"},
+	{"CCIↃↃ", "IↃↃↃ", "CCCIↃↃↃ"}, {"CCCIↃↃↃ", "", ""},
+}
+
+// the places of apostrophus numerals, written with single glyphs
+var apostrophusGlyphs = []romanPlace{
+	{"I", "V", "X"}, {"X", "L", "C"}, {"C", "D", "ↀ"}, {"ↀ", "ↁ", "ↂ"}, {"ↂ", "ↇ", "ↈ"}, {"ↈ", "", ""},
+}
+
+// returns the numeral of the digit d, in the specified place (eg. 4 ==>> "IV")
+func (p romanPlace) digit(d int) (r string) {
+	one, five, ten := p[0], p[1], p[2]
+	switch {
+	case d < 4 || len(five) == 0:
+		r = strings.Repeat(one, d)
+	case d == 4:
+		r = one + five
+	case d < 9:
+		r = five + strings.Repeat(one, d-5)
+	default:
+		r = one + ten
+	}
+	return
+}
+
+// returns the largest number, that may be written with the specified places
+func romanMax(places []romanPlace) (r int) {
+	for i := len(places) - 1; i >= 0; i-- {
+		r *= 10
+		if len(places[i][1]) == 0 {
+			r += 3
+		} else {
+			r += 9
+		}
+	}
+	return
+}
+
+// writes the specified number, with the specified places
+func romanFormat(n int, places []romanPlace) (r string) {
+	var sb strings.Builder
+	place := 1
+	for i := 1; i < len(places); i++ {
+		place *= 10
+	}
+	for i := len(places) - 1; i >= 0; i-- {
+		sb.WriteString(places[i].digit(n / place % 10))
+		place /= 10
+	}
+	r = sb.String()
+	return
+}
+
+// reads a numeral written with the specified places, returning bool true if it is canonical
+func romanParse(s string, places []romanPlace) (r int, ok bool) {
+	rest := s
+	for i := len(places) - 1; i >= 0; i-- {
+		matched, length := 0, 0
+		for d := 1; d <= 9; d++ {
+			if numeral := places[i].digit(d); len(numeral) > length && strings.HasPrefix(rest, numeral) {
+				matched, length = d, len(numeral)
+			}
+		}
+		r = r*10 + matched
+		rest = rest[length:]
+	}
+	ok = len(rest) == 0 && r > 0 && r <= romanMax(places) && romanFormat(r, places) == s
+	return
+}
+
+// returns a codec for Roman numerals, written with any of the specified sets of places
+//   - the first set is written, unless OptGlyphs is set (and there is a second set)
+func romanCodec(id string, placeSets ...[]romanPlace) (r *xl8r.Spoke[string, *big.Int]) {
+	limit := romanMax(placeSets[0])
+	encode := func(v string, _ ...xl8r.Opts) (r *big.Int, e error) {
+		upper := strings.ToUpper(v)
+		if v != upper && v != strings.ToLower(v) {
+			e = fmt.Errorf("mixed letter case [ %s ]", v)
+			return
+		}
+		for _, places := range placeSets {
+			if n, ok := romanParse(upper, places); ok {
+				r = big.NewInt(int64(n))
+				return
+			}
+		}
+		e = fmt.Errorf("invalid Roman numeral [ %s ]", v)
+		return
+	}
+	r = &xl8r.Spoke[string, *big.Int]{
+		Id:  id,
+		Enc: encode,
+		Dec: func(v *big.Int, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(v, opts0); e != nil {
+				return
+			}
+			if v.Sign() < 1 || v.Cmp(big.NewInt(int64(limit))) > 0 {
+				e = fmt.Errorf("no Roman numeral for [ %v ], only for 1 through %d", v, limit)
+				return
+			}
+			places := placeSets[0]
+			if o.glyphs && len(placeSets) > 1 {
+				places = placeSets[1]
+			}
+			if r = romanFormat(int(v.Int64()), places); o.letterCase == CaseLower {
+				r = strings.ToLower(r)
+			}
+			return
+		},
+		Check: func(v string) (r bool) {
+			_, err := encode(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns the codec for standard Roman numerals, from 1 through 3999 (eg. "MMXXIV")
+func Roman() (r *xl8r.Spoke[string, *big.Int]) {
+	r = romanCodec("roman", romanPlaces)
+	return
+}
+
+// returns the codec for Roman numerals with apostrophus thousands, from 1 through 399999
+// (eg. "CIↃ" for 1000, "IↃↃ" for 5000 or "CCIↃↃ" for 10000)
+//   - the single glyphs (eg. "ↀ", "ↁ" or "ↂ") are accepted, and written when OptGlyphs is set
+func Apostrophus() (r *xl8r.Spoke[string, *big.Int]) {
+	r = romanCodec("roman-apostrophus", apostrophusPlaces, apostrophusGlyphs)
+	return
+}
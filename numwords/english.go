@@ -0,0 +1,107 @@
+package numwords
+
+import (
+	"math/big"
+	"strings"
+)
+
+var enOnes = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var enTens = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+
+// the short scale (each a thousand times the previous)
+var enScales = []string{
+	"", "thousand", "million", "billion", "trillion", "quadrillion",
+	"quintillion", "sextillion", "septillion", "octillion", "nonillion", "decillion",
+}
+
+var enOrdinals = map[string]string{
+	"one": "first", "two": "second", "three": "third", "five": "fifth",
+	"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+}
+
+func enBelow1000(n int64) (r string) {
+	var words []string
+	if h := n / 100; h > 0 {
+		words = append(words, enOnes[h], "hundred")
+	}
+	switch n %= 100; {
+	case n == 0:
+	case n < 20:
+		words = append(words, enOnes[n])
+	case n%10 == 0:
+		words = append(words, enTens[n/10])
+	default:
+		words = append(words, enTens[n/10]+"-"+enOnes[n%10])
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+func enCardinal(n *big.Int) (r string) {
+	if n.Sign() == 0 {
+		r = enOnes[0]
+		return
+	}
+	gg := groups(n, 1000)
+	var words []string
+	for i := len(gg) - 1; i >= 0; i-- {
+		if gg[i] > 0 {
+			words = append(words, enBelow1000(gg[i]))
+			if i > 0 {
+				words = append(words, enScales[i])
+			}
+		}
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+// returns the ordinal of a single word (eg. "twenty" ==>> "twentieth")
+func enOrdinalWord(w string) (r string) {
+	var exists bool
+	if r, exists = enOrdinals[w]; !exists {
+		if strings.HasSuffix(w, "y") {
+			r = strings.TrimSuffix(w, "y") + "ieth"
+		} else {
+			r = w + "th"
+		}
+	}
+	return
+}
+
+func enOrdinal(n *big.Int) (r string, e error) {
+	s := enCardinal(n)
+	i := max(strings.LastIndex(s, " "), strings.LastIndex(s, "-"))
+	r = s[:i+1] + enOrdinalWord(s[i+1:])
+	return
+}
+
+var english = func() (r *language) {
+	r = &language{code: "en", minus: "minus", space: " ", cardinal: enCardinal, ordinal: enOrdinal, words: lexicon{}}
+	r.words.add(kindFiller, 0, false, "and", "-", ",")
+	r.words.add(kindMinus, 0, false, "minus", "negative")
+	r.words.add(kindUnit, 1, false, "a")
+	for i, w := range enOnes {
+		r.words.add(kindUnit, int64(i), false, w)
+		if i > 0 {
+			r.words.add(kindUnit, int64(i), true, enOrdinalWord(w))
+		}
+	}
+	for i, w := range enTens[2:] {
+		r.words.add(kindUnit, int64(i+2)*10, false, w)
+		r.words.add(kindUnit, int64(i+2)*10, true, enOrdinalWord(w))
+	}
+	r.words.add(kindMult, 100, false, "hundred")
+	r.words.add(kindMult, 100, true, "hundredth")
+	scale := big.NewInt(1)
+	for _, w := range enScales[1:] {
+		scale = new(big.Int).Mul(scale, big.NewInt(1000))
+		r.words.addBig(kindScale, scale, false, w)
+		r.words.addBig(kindScale, scale, true, enOrdinalWord(w))
+	}
+	return
+}()
@@ -0,0 +1,161 @@
+package numwords
+
+import (
+	"math/big"
+	"strings"
+)
+
+var frOnes = []string{
+	"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+	"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize",
+}
+
+var frTens = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+
+// the long scale (each a thousand times the previous)
+var frScales = []string{
+	"", "mille", "million", "milliard", "billion", "billiard", "trillion",
+	"trilliard", "quadrillion", "quadrilliard", "quintillion", "quintilliard",
+}
+
+func frBelow100(n int64) (r string) {
+	switch t, u := n/10, n%10; {
+	case n < 17:
+		r = frOnes[n]
+	case n < 20:
+		r = "dix-" + frOnes[u]
+	case n < 70 && u == 0:
+		r = frTens[t]
+	case n < 70 && u == 1:
+		r = frTens[t] + " et un"
+	case n < 70:
+		r = frTens[t] + "-" + frOnes[u]
+	case n == 71:
+		r = "soixante et onze"
+	case n < 80:
+		r = "soixante-" + frBelow100(n-60)
+	case n == 80:
+		r = "quatre-vingts"
+	default:
+		r = "quatre-vingt-" + frBelow100(n-80)
+	}
+	return
+}
+
+func frBelow1000(n int64) (r string) {
+	switch h, rest := n/100, n%100; {
+	case h == 0:
+		r = frBelow100(rest)
+	case h == 1 && rest == 0:
+		r = "cent"
+	case h == 1:
+		r = "cent " + frBelow100(rest)
+	case rest == 0:
+		r = frOnes[h] + " cents"
+	default:
+		r = frOnes[h] + " cent " + frBelow100(rest)
+	}
+	return
+}
+
+func frCardinal(n *big.Int) (r string) {
+	if n.Sign() == 0 {
+		r = frOnes[0]
+		return
+	}
+	gg := groups(n, 1000)
+	var words []string
+	for i := len(gg) - 1; i >= 0; i-- {
+		switch {
+		case gg[i] == 0:
+		case i == 0:
+			words = append(words, frBelow1000(gg[i]))
+		case i == 1 && gg[i] == 1:
+			words = append(words, "mille")
+		case i == 1:
+			// "vingts" and "cents" lose their "s", before "mille"
+			count := frBelow1000(gg[i])
+			if strings.HasSuffix(count, "vingts") || strings.HasSuffix(count, "cents") {
+				count = strings.TrimSuffix(count, "s")
+			}
+			words = append(words, count, "mille")
+		case gg[i] == 1:
+			words = append(words, "un", frScales[i])
+		default:
+			words = append(words, frBelow1000(gg[i]), frScales[i]+"s")
+		}
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+// returns the ordinal of a single word (eg. "quatre" ==>> "quatrième")
+func frOrdinalWord(w string) (r string) {
+	switch {
+	case w == "cinq":
+		r = "cinquième"
+	case w == "neuf":
+		r = "neuvième"
+	case w == "vingts" || w == "cents" || strings.HasSuffix(w, "ons") || strings.HasSuffix(w, "ards"):
+		r = strings.TrimSuffix(w, "s") + "ième"
+	case strings.HasSuffix(w, "e"):
+		r = strings.TrimSuffix(w, "e") + "ième"
+	default:
+		r = w + "ième"
+	}
+	return
+}
+
+func frOrdinal(n *big.Int) (r string, e error) {
+	if n.IsInt64() && n.Int64() == 1 {
+		r = "premier"
+		return
+	}
+	s := frCardinal(n)
+	if words := strings.Fields(s); len(words) == 2 && words[0] == "un" {
+		// eg. "millionième", rather than "un millionième"
+		s = words[1]
+	}
+	i := max(strings.LastIndex(s, " "), strings.LastIndex(s, "-"))
+	r = s[:i+1] + frOrdinalWord(s[i+1:])
+	return
+}
+
+// returns bool true, if the unit v may follow the unit last (eg. "soixante" "dix")
+func frFollows(last, v int64) (r bool) {
+	r = (v < last && digits(v) < digits(last)) || ((last == 60 || last == 80) && v >= 10 && v < 20)
+	return
+}
+
+var french = func() (r *language) {
+	r = &language{code: "fr", minus: "moins", space: " ", cardinal: frCardinal, ordinal: frOrdinal, follows: frFollows, words: lexicon{},
+		bare: map[int64]bool{100: true, 1000: true}, bareOrdinals: true}
+	r.words.add(kindFiller, 0, false, "et", "-", ",")
+	r.words.add(kindMinus, 0, false, "moins")
+	for i, w := range frOnes {
+		r.words.add(kindUnit, int64(i), false, w)
+		if i > 1 {
+			r.words.add(kindUnit, int64(i), true, frOrdinalWord(w))
+		}
+	}
+	r.words.add(kindUnit, 1, false, "une")
+	r.words.add(kindUnit, 1, true, "premier", "première", "unième")
+	r.words.add(kindUnit, 2, true, "second", "seconde")
+	for i, w := range frTens[2:] {
+		r.words.add(kindUnit, int64(i+2)*10, false, w)
+		r.words.add(kindUnit, int64(i+2)*10, true, frOrdinalWord(w))
+	}
+	r.words.add(kindUnit, 80, false, "quatre-vingt", "quatre-vingts")
+	r.words.add(kindUnit, 80, true, "quatre-vingtième")
+	r.words.add(kindMult, 100, false, "cent", "cents")
+	r.words.add(kindMult, 100, true, "centième")
+	r.words.add(kindScale, 1000, false, "mille", "mil")
+	r.words.add(kindScale, 1000, true, "millième")
+	scale := big.NewInt(1000)
+	for _, w := range frScales[2:] {
+		scale = new(big.Int).Mul(scale, big.NewInt(1000))
+		r.words.addBig(kindScale, scale, false, w, w+"s")
+		r.words.addBig(kindScale, scale, true, frOrdinalWord(w))
+	}
+	return
+}()
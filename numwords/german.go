@@ -0,0 +1,166 @@
+package numwords
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+var deOnes = []string{
+	"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+	"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn",
+}
+
+var deTens = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+
+// the long scale (each a thousand times the previous), from a million, as singular and plural
+var deScales = [][2]string{
+	{"Million", "Millionen"}, {"Milliarde", "Milliarden"}, {"Billion", "Billionen"}, {"Billiarde", "Billiarden"},
+	{"Trillion", "Trillionen"}, {"Trilliarde", "Trilliarden"}, {"Quadrillion", "Quadrillionen"},
+	{"Quadrilliarde", "Quadrilliarden"}, {"Quintillion", "Quintillionen"}, {"Quintilliarde", "Quintilliarden"},
+}
+
+// the irregular ordinals below 20
+var deOrdinals = map[int64]string{1: "erste", 3: "dritte", 7: "siebte", 8: "achte"}
+
+// the endings of ordinals
+var deEndings = []string{"e", "er", "en", "es", "em"}
+
+// spells out the specified number, below 100
+//   - "eins" is shortened to "ein", unless it is final (eg. "einundzwanzig", "hunderteins")
+func deBelow100(n int64, final bool) (r string) {
+	switch u := n % 10; {
+	case n == 1 && !final:
+		r = "ein"
+	case n < 20:
+		r = deOnes[n]
+	case u == 0:
+		r = deTens[n/10]
+	default:
+		r = deBelow100(u, false) + "und" + deTens[n/10]
+	}
+	return
+}
+
+func deBelow1000(n int64, final bool) (r string) {
+	if h := n / 100; h > 0 {
+		r = deBelow100(h, false) + "hundert"
+	}
+	if n%100 > 0 {
+		r += deBelow100(n%100, final)
+	}
+	return
+}
+
+func deCardinal(n *big.Int) (r string) {
+	if n.Sign() == 0 {
+		r = deOnes[0]
+		return
+	}
+	gg := append(groups(n, 1000), 0)
+	var words []string
+	for i := len(gg) - 1; i >= 2; i-- {
+		switch {
+		case gg[i] == 0:
+		case gg[i] == 1:
+			words = append(words, "eine", deScales[i-2][0])
+		default:
+			words = append(words, deBelow1000(gg[i], false), deScales[i-2][1])
+		}
+	}
+	// numbers below a million are written as a single word
+	if gg[0] > 0 || gg[1] > 0 {
+		word := ""
+		if gg[1] > 0 {
+			word = deBelow1000(gg[1], false) + "tausend"
+		}
+		words = append(words, word+deBelow1000(gg[0], true))
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+// returns the ordinal of a number below 20
+func deOrdinalSmall(n int64) (r string) {
+	var exists bool
+	if r, exists = deOrdinals[n]; !exists {
+		r = deOnes[n] + "te"
+	}
+	return
+}
+
+// returns the ordinal of a scale (eg. "Milliarde" ==>> "milliardste")
+func deOrdinalScale(singular string) (r string) {
+	r = strings.TrimSuffix(strings.ToLower(singular), "e") + "ste"
+	return
+}
+
+func deOrdinal(n *big.Int) (r string, e error) {
+	words := strings.Fields(deCardinal(n))
+	last := words[len(words)-1]
+	if unicode.IsUpper([]rune(last)[0]) {
+		// eg. "zwei Millionen" ==>> "zweimillionste"
+		for _, scale := range deScales {
+			if last == scale[0] || last == scale[1] {
+				last = deOrdinalScale(scale[0])
+			}
+		}
+		words = words[:len(words)-1]
+		if prev := words[len(words)-1]; prev == "eine" {
+			words = words[:len(words)-1]
+		} else {
+			words, last = words[:len(words)-1], prev+last
+		}
+	} else if rest := new(big.Int).Rem(n, big.NewInt(100)).Int64(); rest > 0 && rest < 20 {
+		words = words[:len(words)-1]
+		last = strings.TrimSuffix(last, deBelow100(rest, true)) + deOrdinalSmall(rest)
+	} else {
+		words = words[:len(words)-1]
+		last += "ste"
+	}
+	r = strings.Join(append(words, last), " ")
+	return
+}
+
+// returns bool true, if the unit v may follow the unit last (eg. "ein" "und" "zwanzig")
+func deFollows(last, v int64) (r bool) {
+	r = (v < last && digits(v) < digits(last)) || (last < 10 && v >= 20 && v < 100 && v%10 == 0)
+	return
+}
+
+// adds the inflected forms of the specified ordinal (eg. "erste", "erster", "ersten")
+func (x lexicon) addGerman(k kind, value *big.Int, ordinal string) {
+	stem := strings.TrimSuffix(ordinal, "e")
+	for _, ending := range deEndings {
+		x.addBig(k, value, true, stem+ending)
+	}
+}
+
+var german = func() (r *language) {
+	r = &language{code: "de", minus: "minus", space: " ", cardinal: deCardinal, ordinal: deOrdinal, follows: deFollows, words: lexicon{},
+		bare: map[int64]bool{100: true, 1000: true}, bareOrdinals: true}
+	r.words.add(kindFiller, 0, false, "und", "-", ",")
+	r.words.add(kindMinus, 0, false, "minus")
+	for i, w := range deOnes {
+		r.words.add(kindUnit, int64(i), false, w)
+		if i > 0 {
+			r.words.addGerman(kindUnit, big.NewInt(int64(i)), deOrdinalSmall(int64(i)))
+		}
+	}
+	r.words.add(kindUnit, 1, false, "ein", "eine")
+	for i, w := range deTens[2:] {
+		r.words.add(kindUnit, int64(i+2)*10, false, w)
+		r.words.addGerman(kindUnit, big.NewInt(int64(i+2)*10), w+"ste")
+	}
+	r.words.add(kindMult, 100, false, "hundert")
+	r.words.addGerman(kindMult, big.NewInt(100), "hundertste")
+	r.words.add(kindScale, 1000, false, "tausend")
+	r.words.addGerman(kindScale, big.NewInt(1000), "tausendste")
+	scale := big.NewInt(1000)
+	for _, w := range deScales {
+		scale = new(big.Int).Mul(scale, big.NewInt(1000))
+		r.words.addBig(kindScale, scale, false, w[0], w[1])
+		r.words.addGerman(kindScale, scale, deOrdinalScale(w[0]))
+	}
+	return
+}()
@@ -0,0 +1,79 @@
+package numwords
+
+import (
+	"math/big"
+	"strings"
+)
+
+var jaDigits = []string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// the multipliers within a group of 4 digits
+var jaMults = []struct {
+	value int64
+	text  string
+}{{1000, "千"}, {100, "百"}, {10, "十"}}
+
+// the myriad scale (each ten thousand times the previous)
+var jaScales = []string{"", "万", "億", "兆", "京", "垓", "秭", "穣", "溝"}
+
+// spells out the specified group of 4 digits
+//   - "一千" is shortened to "千", if bare (ie. without any scale)
+func jaBelow10000(n int64, bare bool) (r string) {
+	var sb strings.Builder
+	for _, m := range jaMults {
+		switch d := n / m.value % 10; {
+		case d == 0:
+		case d == 1 && (bare || m.value < 1000):
+			sb.WriteString(m.text)
+		default:
+			sb.WriteString(jaDigits[d] + m.text)
+		}
+	}
+	if d := n % 10; d > 0 {
+		sb.WriteString(jaDigits[d])
+	}
+	r = sb.String()
+	return
+}
+
+func jaCardinal(n *big.Int) (r string) {
+	if n.Sign() == 0 {
+		r = "零"
+		return
+	}
+	gg := groups(n, 10000)
+	var sb strings.Builder
+	for i := len(gg) - 1; i >= 0; i-- {
+		if gg[i] > 0 {
+			sb.WriteString(jaBelow10000(gg[i], len(gg) == 1) + jaScales[i])
+		}
+	}
+	r = sb.String()
+	return
+}
+
+func jaOrdinal(n *big.Int) (r string, e error) {
+	r = "第" + jaCardinal(n)
+	return
+}
+
+var japanese = func() (r *language) {
+	r = &language{code: "ja", minus: "マイナス", cardinal: jaCardinal, ordinal: jaOrdinal, words: lexicon{},
+		bare: map[int64]bool{10: true, 100: true, 1000: true}}
+	r.words.add(kindFiller, 0, false, "、", ",")
+	r.words.add(kindMinus, 0, false, "マイナス")
+	r.words.add(kindOrdinalMark, 0, false, "第")
+	r.words.add(kindUnit, 0, false, "零")
+	for i, w := range jaDigits {
+		r.words.add(kindUnit, int64(i), false, w)
+	}
+	for _, m := range jaMults {
+		r.words.add(kindMult, m.value, false, m.text)
+	}
+	scale := big.NewInt(1)
+	for _, w := range jaScales[1:] {
+		scale = new(big.Int).Mul(scale, big.NewInt(10000))
+		r.words.addBig(kindScale, scale, false, w)
+	}
+	return
+}()
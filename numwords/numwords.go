@@ -0,0 +1,381 @@
+/*
+Package numwords provides codecs for integers spelled out in words (eg. "twenty-one" or "vingt et unième"),
+with *big.Int hub data.
+
+	spellNumber, err := numwords.New(xl8r.Config{})
+	words, err := spellNumber.To("fr/cardinal", "en/cardinal", "ninety-one")	// "quatre-vingt-onze"
+
+Codecs are named by language and form, so that each language is a namespace (see xl8r.Interpreter.Namespace):
+  - "en/cardinal" and "en/ordinal" (English, short scale)
+  - "es/cardinal" and "es/ordinal" (Spanish, long scale)
+  - "fr/cardinal" and "fr/ordinal" (French, long scale)
+  - "de/cardinal" and "de/ordinal" (German, long scale)
+  - "ja/cardinal" and "ja/ordinal" (Japanese, in kanji)
+
+Numbers up to (but not including) 10^36 are supported. Ordinals are supported from 1, and
+Spanish ordinals below 10^9. Parsing is case-insensitive, and tolerates missing accents.
+
+The codecs are also registered under the "numwords" domain (see xl8r.NewFromDomain).
+*/
+package numwords
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "numwords"
+
+// the form of spelled-out numbers
+type Form string
+
+const (
+	// eg. "twenty-one"
+	Cardinal Form = "cardinal"
+	// eg. "twenty-first"
+	Ordinal Form = "ordinal"
+)
+
+// the (exclusive) limit of the magnitude of numbers
+var limit = new(big.Int).Exp(big.NewInt(10), big.NewInt(36), nil)
+
+// the kind of a word
+type kind int
+
+const (
+	// a number that is added (eg. "twenty", "one")
+	kindUnit kind = iota
+	// a multiplier within a group (eg. "hundred")
+	kindMult
+	// a multiplier of all preceding smaller groups (eg. "thousand", "million")
+	kindScale
+	// a word without a value (eg. "and", "-")
+	kindFiller
+	// marks a negative number (eg. "minus")
+	kindMinus
+	// marks an ordinal number (eg. "第")
+	kindOrdinalMark
+)
+
+// a word of a language
+type lexeme struct {
+	kind    kind
+	value   *big.Int
+	ordinal bool
+}
+
+// the words of a language, keyed by their folded text
+type lexicon map[string]lexeme
+
+func (x lexicon) add(k kind, value int64, ordinal bool, words ...string) {
+	x.addBig(k, big.NewInt(value), ordinal, words...)
+}
+
+func (x lexicon) addBig(k kind, value *big.Int, ordinal bool, words ...string) {
+	for _, word := range words {
+		key := fold(word)
+		if existing, dup := x[key]; dup && (existing.kind != k || existing.ordinal != ordinal || existing.value.Cmp(value) != 0) {
+			panic(fmt.Sprintf("numwords: conflicting word '%s'", word))
+		}
+		x[key] = lexeme{kind: k, value: value, ordinal: ordinal}
+	}
+}
+
+var folder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "î", "i", "ï", "i",
+	"ó", "o", "ô", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n", "ß", "ss",
+)
+
+// lower-cases the specified text, and removes its accents
+func fold(s string) string {
+	return folder.Replace(strings.ToLower(s))
+}
+
+// a language, that numbers are spelled out in
+type language struct {
+	// the language code (eg. "en"), used as the namespace of its codecs
+	code string
+	// the word (or prefix) of negative numbers
+	minus string
+	// the separator of words
+	space string
+	// spells out a positive number, or zero
+	cardinal func(n *big.Int) string
+	// spells out a positive number, as an ordinal
+	ordinal func(n *big.Int) (r string, e error)
+	words   lexicon
+	// optional function that returns bool true, if the unit v may follow the unit last
+	//   - by default, units must decrease in size (eg. "twenty" "one")
+	follows func(last, v int64) bool
+	// the values of the multipliers and scales, that may be used without a preceding number (eg. 1000 for "mille")
+	//   - other multipliers and scales must follow a number (eg. "one thousand", not "thousand")
+	bare map[int64]bool
+	// when bool true, ordinal scales may also be used without a preceding number (eg. "millionième")
+	bareOrdinals bool
+	// when bool true, an ordinal scale may be followed by the words of smaller segments,
+	// that end in an ordinal (eg. "dosmilésimo tercero")
+	ordinalSegments bool
+}
+
+// returns bool true, if the language has a scale word of the specified value (eg. "billion" for 10^9)
+func (l *language) isScale(v *big.Int) bool {
+	for _, word := range l.words {
+		if word.kind == kindScale && word.value.Cmp(v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// returns bool true, if the specified multiplier or scale may be used without a preceding number
+func (l *language) isBare(word lexeme) bool {
+	return (word.value.IsInt64() && l.bare[word.value.Int64()]) || (word.ordinal && word.kind == kindScale && l.bareOrdinals)
+}
+
+// returns the groups of the specified non-negative number, from the least significant
+func groups(n *big.Int, size int64) (r []int64) {
+	rest, base, mod := new(big.Int).Set(n), big.NewInt(size), new(big.Int)
+	for rest.Sign() > 0 {
+		rest.DivMod(rest, base, mod)
+		r = append(r, mod.Int64())
+	}
+	return
+}
+
+func digits(v int64) (r int) {
+	for r = 1; v >= 10; v /= 10 {
+		r++
+	}
+	return
+}
+
+// splits the specified text into words of the language, matching the longest known word first
+//   - compound words (eg. "dreihundert") are split into their parts
+func (l *language) tokenize(v string) (r []lexeme, e error) {
+	maxLen := 0
+	for key := range l.words {
+		maxLen = max(maxLen, len(key))
+	}
+	for _, field := range strings.Fields(fold(v)) {
+		for i := 0; i < len(field); {
+			matched := false
+			for j := min(len(field), i+maxLen); j > i; j-- {
+				if word, exists := l.words[field[i:j]]; exists {
+					r = append(r, word)
+					i, matched = j, true
+					break
+				}
+			}
+			if !matched {
+				e = fmt.Errorf("unknown word [ %s ]", field)
+				return
+			}
+		}
+	}
+	return
+}
+
+// parses the specified spelled-out number
+func (l *language) parse(v string) (r *big.Int, ordinal bool, e error) {
+	var words []lexeme
+	if words, e = l.tokenize(v); e != nil {
+		return
+	}
+	follows := l.follows
+	if follows == nil {
+		follows = func(last, v int64) bool { return v < last && digits(v) < digits(last) }
+	}
+
+	// the segments of the number (eg. 2000 and 300, for "two thousand three hundred"),
+	// and the scale of each (eg. 1000), which must decrease
+	var segments, scales []*big.Int
+	group, pending := new(big.Int), new(big.Int)
+	var last int64
+	var lastMult *big.Int
+	negative, numeric, marked := false, false, false
+	// bool true, once any ordinal word is read, and right after an ordinal scale (eg. "milésimo")
+	sawOrdinal, afterOrdinalScale := false, false
+	for i, word := range words {
+		switch word.kind {
+		case kindMinus:
+			if i > 0 {
+				e = fmt.Errorf("unexpected minus, in [ %s ]", v)
+				return
+			}
+			negative = true
+			continue
+		case kindOrdinalMark:
+			if numeric || marked {
+				e = fmt.Errorf("unexpected ordinal mark, in [ %s ]", v)
+				return
+			}
+			marked = true
+			continue
+		case kindFiller:
+			continue
+		}
+		if ordinal && !word.ordinal && !(l.ordinalSegments && afterOrdinalScale) {
+			e = fmt.Errorf("unexpected word after an ordinal, in [ %s ]", v)
+			return
+		}
+		numeric, ordinal = true, word.ordinal
+		sawOrdinal, afterOrdinalScale = sawOrdinal || word.ordinal, word.ordinal && word.kind == kindScale
+		switch word.kind {
+		case kindUnit:
+			value := word.value.Int64()
+			if pending.Sign() != 0 && !follows(last, value) {
+				e = fmt.Errorf("unexpected number word, in [ %s ]", v)
+				return
+			}
+			pending.Add(pending, word.value)
+			last = value
+		case kindMult:
+			if lastMult != nil && word.value.Cmp(lastMult) >= 0 {
+				e = fmt.Errorf("unexpected multiplier, in [ %s ]", v)
+				return
+			}
+			if pending.Sign() == 0 {
+				if !l.isBare(word) {
+					e = fmt.Errorf("unexpected multiplier, in [ %s ]", v)
+					return
+				}
+				pending.SetInt64(1)
+			}
+			group.Add(group, pending.Mul(pending, word.value))
+			pending, last, lastMult = new(big.Int), 0, word.value
+		case kindScale:
+			// smaller segments are multiplied too (eg. "mil millones", for 10^9),
+			// unless the language has a word for the product (eg. "billion", rather than "thousand million")
+			m, scale := new(big.Int).Add(group, pending), word.value
+			for len(segments) > 0 && segments[len(segments)-1].Cmp(word.value) < 0 {
+				product := new(big.Int).Mul(word.value, scales[len(scales)-1])
+				if l.isScale(product) {
+					break
+				}
+				m.Add(m, segments[len(segments)-1])
+				scale = product
+				segments, scales = segments[:len(segments)-1], scales[:len(scales)-1]
+			}
+			if m.Sign() == 0 {
+				if !l.isBare(word) {
+					e = fmt.Errorf("unexpected scale word, in [ %s ]", v)
+					return
+				}
+				m.SetInt64(1)
+			}
+			if len(scales) > 0 && scale.Cmp(scales[len(scales)-1]) >= 0 {
+				e = fmt.Errorf("unexpected scale word, in [ %s ]", v)
+				return
+			}
+			segments, scales = append(segments, m.Mul(m, word.value)), append(scales, scale)
+			group, pending, last, lastMult = new(big.Int), new(big.Int), 0, nil
+		}
+	}
+	if !numeric {
+		e = fmt.Errorf("no number words, in [ %s ]", v)
+		return
+	}
+	if sawOrdinal && !ordinal {
+		e = fmt.Errorf("unexpected word after an ordinal, in [ %s ]", v)
+		return
+	}
+	ordinal = ordinal || marked
+	r = new(big.Int).Add(group, pending)
+	for _, segment := range segments {
+		r.Add(r, segment)
+	}
+	if negative {
+		r.Neg(r)
+	}
+	if ordinal && r.Sign() < 1 {
+		r, e = nil, fmt.Errorf("no ordinal for [ %s ]", v)
+	}
+	return
+}
+
+// spells out the specified number
+func (l *language) spell(v *big.Int, form Form) (r string, e error) {
+	switch {
+	case v == nil:
+		e = fmt.Errorf("nil number")
+	case new(big.Int).Abs(v).Cmp(limit) >= 0:
+		e = fmt.Errorf("number too large [ %v ]", v)
+	case form == Ordinal && v.Sign() < 1:
+		e = fmt.Errorf("no ordinal for [ %v ]", v)
+	case form == Ordinal:
+		r, e = l.ordinal(v)
+	case v.Sign() < 0:
+		r = l.minus + l.space + l.cardinal(new(big.Int).Neg(v))
+	default:
+		r = l.cardinal(v)
+	}
+	return
+}
+
+// returns the codec for numbers spelled out in the specified language and form
+func (l *language) codec(form Form) (r *xl8r.Spoke[string, *big.Int]) {
+	encode := func(v string, _ ...xl8r.Opts) (r *big.Int, e error) {
+		var ordinal bool
+		if r, ordinal, e = l.parse(v); e != nil {
+			r = nil
+			return
+		}
+		if ordinal != (form == Ordinal) {
+			r, e = nil, fmt.Errorf("not a %s number [ %s ]", form, v)
+		}
+		return
+	}
+	r = &xl8r.Spoke[string, *big.Int]{
+		Id:  l.code + "/" + string(form),
+		Enc: encode,
+		Dec: func(v *big.Int, _ ...xl8r.Opts) (string, error) {
+			return l.spell(v, form)
+		},
+		Check: func(v string) (r bool) {
+			_, err := encode(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+var languages = []*language{english, spanish, french, german, japanese}
+
+// returns the sorted codes of the supported languages
+func Languages() (r []string) {
+	for _, l := range languages {
+		r = append(r, l.code)
+	}
+	sort.Strings(r)
+	return
+}
+
+// returns the codecs of this package (see the package documentation)
+func Codecs() (r []xl8r.Codec[string, *big.Int]) {
+	for _, l := range languages {
+		r = append(r, l.codec(Cardinal), l.codec(Ordinal))
+	}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, *big.Int], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
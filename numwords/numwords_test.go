@@ -0,0 +1,169 @@
+package numwords
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestNumberWords(t *testing.T) {
+	spellNumber, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "fr/cardinal", from: "en/cardinal", text: "ninety-one", expected: "quatre-vingt-onze"},
+		{to: "en/cardinal", from: "fr/cardinal", text: "soixante et onze", expected: "seventy-one"},
+		{to: "en/cardinal", from: "fr/cardinal", text: "quatre-vingt-dix-sept", expected: "ninety-seven"},
+		{to: "fr/cardinal", from: "en/cardinal", text: "eighty thousand", expected: "quatre-vingt mille"},
+		{to: "fr/cardinal", from: "en/cardinal", text: "two hundred million", expected: "deux cents millions"},
+		{to: "es/cardinal", from: "en/cardinal", text: "twenty-one thousand", expected: "veintiún mil"},
+		{to: "es/cardinal", from: "en/cardinal", text: "one billion", expected: "mil millones"},
+		{to: "es/cardinal", from: "en/cardinal", text: "one hundred", expected: "cien"},
+		{to: "en/cardinal", from: "es/cardinal", text: "dos mil trescientos millones", expected: "two billion three hundred million"},
+		{to: "en/cardinal", from: "es/cardinal", text: "Dieciseis", expected: "sixteen"},
+		{to: "de/cardinal", from: "en/cardinal", text: "two million one", expected: "zwei Millionen eins"},
+		{to: "en/cardinal", from: "de/cardinal", text: "dreihundertfünfundzwanzigtausend", expected: "three hundred twenty-five thousand"},
+		{to: "en/cardinal", from: "de/cardinal", text: "eine Milliarde", expected: "one billion"},
+		{to: "ja/cardinal", from: "en/cardinal", text: "one hundred twenty-three thousand four hundred fifty-six", expected: "十二万三千四百五十六"},
+		{to: "ja/cardinal", from: "en/cardinal", text: "twenty-one thousand", expected: "二万一千"},
+		{to: "en/cardinal", from: "ja/cardinal", text: "一億二千万", expected: "one hundred twenty million"},
+		{to: "en/cardinal", from: "ja/cardinal", text: "マイナス十", expected: "minus ten"},
+		{to: "de/cardinal", from: "en/cardinal", text: "negative zero", expected: "null"},
+		{to: "es/cardinal", from: "fr/cardinal", text: "moins mille", expected: "menos mil"},
+		{to: "en/cardinal", from: "en/cardinal", text: "one decillion", expected: "one decillion"},
+		{to: "en/cardinal", from: "en/cardinal", text: "one thousand decillion", expectedErr: true}, // too large
+		{to: "en/cardinal", from: "en/cardinal", text: "one two", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "hundred hundred", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "twenty one minus", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "twenty quid", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "and", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "thousand", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "hundred", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "one thousand hundred", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "one thousand thousand", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "one million thousand", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "two thousand three thousand", expectedErr: true},
+		{to: "en/cardinal", from: "en/cardinal", text: "two million one billion", expectedErr: true},
+		{to: "en/cardinal", from: "es/cardinal", text: "mil mil", expectedErr: true},
+		{to: "en/cardinal", from: "fr/cardinal", text: "mil mil", expectedErr: true},
+		{to: "en/cardinal", from: "fr/cardinal", text: "mille mille", expectedErr: true},
+		{to: "en/cardinal", from: "de/cardinal", text: "tausend tausend", expectedErr: true},
+		{to: "en/cardinal", from: "ja/cardinal", text: "万", expectedErr: true},
+		{to: "en/cardinal", from: "es/cardinal", text: "un millón mil", expected: "one million one thousand"},
+		{to: "en/cardinal", from: "fr/cardinal", text: "cent mille", expected: "one hundred thousand"},
+		{to: "en/cardinal", from: "ja/cardinal", text: "千百", expected: "one thousand one hundred"},
+		{to: "en/cardinal", from: "en/ordinal", text: "third", expected: "three"},
+		{to: "en/cardinal", from: "en/cardinal", text: "third", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := spellNumber.To(tx.to, tx.from, tx.text)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s") ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestOrdinals(t *testing.T) {
+	spellNumber, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "en/ordinal", from: "en/cardinal", text: "twenty-one", expected: "twenty-first"},
+		{to: "en/ordinal", from: "en/cardinal", text: "forty", expected: "fortieth"},
+		{to: "en/ordinal", from: "en/cardinal", text: "one million", expected: "one millionth"},
+		{to: "es/ordinal", from: "en/ordinal", text: "twelfth", expected: "décimo segundo"},
+		{to: "es/ordinal", from: "en/ordinal", text: "two thousand third", expected: "dosmilésimo tercero"},
+		{to: "en/ordinal", from: "es/ordinal", text: "vigésima primera", expected: "twenty-first"},
+		{to: "en/ordinal", from: "es/ordinal", text: "undécimo", expected: "eleventh"},
+		{to: "es/ordinal", from: "en/ordinal", text: "one billionth", expectedErr: true},
+		{to: "fr/ordinal", from: "en/ordinal", text: "first", expected: "premier"},
+		{to: "fr/ordinal", from: "en/ordinal", text: "twenty-first", expected: "vingt et unième"},
+		{to: "fr/ordinal", from: "en/ordinal", text: "eightieth", expected: "quatre-vingtième"},
+		{to: "fr/ordinal", from: "en/ordinal", text: "ninth", expected: "neuvième"},
+		{to: "en/ordinal", from: "fr/ordinal", text: "millième", expected: "one thousandth"},
+		{to: "de/ordinal", from: "en/ordinal", text: "third", expected: "dritte"},
+		{to: "de/ordinal", from: "en/ordinal", text: "one hundred first", expected: "einhunderterste"},
+		{to: "de/ordinal", from: "en/ordinal", text: "twenty-first", expected: "einundzwanzigste"},
+		{to: "de/ordinal", from: "en/ordinal", text: "two millionth", expected: "zweimillionste"},
+		{to: "en/ordinal", from: "de/ordinal", text: "siebter", expected: "seventh"},
+		{to: "ja/ordinal", from: "en/ordinal", text: "third", expected: "第三"},
+		{to: "en/ordinal", from: "ja/ordinal", text: "第十二", expected: "twelfth"},
+		{to: "en/ordinal", from: "ja/ordinal", text: "十二", expectedErr: true},
+		{to: "en/ordinal", from: "en/cardinal", text: "zero", expectedErr: true},
+		{to: "en/ordinal", from: "en/cardinal", text: "minus one", expectedErr: true},
+		{to: "en/cardinal", from: "en/ordinal", text: "minus first", expectedErr: true},
+		{to: "en/cardinal", from: "en/ordinal", text: "first hundred", expectedErr: true},
+		{to: "en/ordinal", from: "es/ordinal", text: "dosmillonésimo cuatrocientosmilésimo tercero", expected: "two million four hundred thousand third"},
+		{to: "en/cardinal", from: "es/ordinal", text: "dosmillonésimo cuatrocientos", expectedErr: true},
+		{to: "en/cardinal", from: "en/ordinal", text: "millionth", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := spellNumber.To(tx.to, tx.from, tx.text)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s") ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestMalformedWords(t *testing.T) {
+	spellNumber, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range []string{"one thousand thousand", "thousand", "hundred", "mil mil", "mille mille"} {
+		if origins := spellNumber.Origins(text); len(origins) != 0 {
+			t.Errorf(`Origins("%s") ==>> %v, expected none`, text, origins)
+		}
+	}
+}
+
+func TestRoundTrips(t *testing.T) {
+	numbers := []int64{0, 1, 7, 11, 16, 17, 21, 71, 80, 81, 91, 99, 100, 101, 121, 200, 999, 1000, 1001, 2000, 21000,
+		80000, 100000, 123456, 1000000, 1001000, 2000001, 2403003, 1000000000, 21000000000, 1000000000000, 999999999999999999}
+	for _, c := range Codecs() {
+		for _, number := range numbers {
+			n := big.NewInt(number)
+			text, dErr := c.Decode(n)
+			if dErr != nil {
+				if number > 0 && number < 1e9 {
+					t.Errorf(`%s: Decode(%d) ==>> %v`, c.Name(), number, dErr)
+				}
+				continue
+			}
+			if hub, eErr := c.Encode(text); eErr != nil || hub.Cmp(n) != 0 {
+				t.Errorf(`%s: Encode("%s") ==>> %v %v, expected %d`, c.Name(), text, hub, eErr, number)
+			}
+		}
+	}
+}
+
+func TestNumberWordsDomain(t *testing.T) {
+	if languages := Languages(); len(languages) != 5 || languages[0] != "de" {
+		t.Errorf("Languages() ==>> %v", languages)
+	}
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+	spellNumber, err := xl8r.NewFromDomain[string, *big.Int](xl8r.Config{}, Domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	german := spellNumber.Namespace("de")
+	if result, tErr := german.To("cardinal", "ordinal", "zwölfte"); tErr != nil || result != "zwölf" {
+		t.Errorf(`To("cardinal","ordinal","zwölfte") ==>> "%s" %v`, result, tErr)
+	}
+}
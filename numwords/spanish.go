@@ -0,0 +1,201 @@
+package numwords
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var esUnits = []string{
+	"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+	"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+	"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+}
+
+var esTens = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+
+var esHundreds = []string{
+	"", "ciento", "doscientos", "trescientos", "cuatrocientos",
+	"quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos",
+}
+
+// the long scale (each a million times the previous), as singular and plural
+var esScales = [][2]string{
+	{"", ""}, {"millón", "millones"}, {"billón", "billones"}, {"trillón", "trillones"},
+	{"cuatrillón", "cuatrillones"}, {"quintillón", "quintillones"},
+}
+
+var esOrdUnits = []string{"", "primero", "segundo", "tercero", "cuarto", "quinto", "sexto", "séptimo", "octavo", "noveno"}
+
+var esOrdTens = []string{
+	"", "décimo", "vigésimo", "trigésimo", "cuadragésimo",
+	"quincuagésimo", "sexagésimo", "septuagésimo", "octogésimo", "nonagésimo",
+}
+
+var esOrdHundreds = []string{
+	"", "centésimo", "ducentésimo", "tricentésimo", "cuadringentésimo",
+	"quingentésimo", "sexcentésimo", "septingentésimo", "octingentésimo", "noningentésimo",
+}
+
+// the limit of Spanish ordinals
+var esOrdinalLimit = big.NewInt(1e9)
+
+func esBelow100(n int64) (r string) {
+	switch {
+	case n < 30:
+		r = esUnits[n]
+	case n%10 == 0:
+		r = esTens[n/10]
+	default:
+		r = esTens[n/10] + " y " + esUnits[n%10]
+	}
+	return
+}
+
+func esBelow1000(n int64) (r string) {
+	switch h, rest := n/100, n%100; {
+	case n == 100:
+		r = "cien"
+	case h == 0:
+		r = esBelow100(rest)
+	case rest == 0:
+		r = esHundreds[h]
+	default:
+		r = esHundreds[h] + " " + esBelow100(rest)
+	}
+	return
+}
+
+// shortens a trailing "uno", before a noun (eg. "veintiuno" ==>> "veintiún")
+func esApocope(s string) (r string) {
+	switch {
+	case strings.HasSuffix(s, "veintiuno"):
+		r = strings.TrimSuffix(s, "veintiuno") + "veintiún"
+	case strings.HasSuffix(s, "uno"):
+		r = strings.TrimSuffix(s, "uno") + "un"
+	default:
+		r = s
+	}
+	return
+}
+
+func esBelowMillion(n int64) (r string) {
+	var words []string
+	switch t := n / 1000; {
+	case t == 1:
+		words = append(words, "mil")
+	case t > 1:
+		words = append(words, esApocope(esBelow1000(t)), "mil")
+	}
+	if n%1000 > 0 || n == 0 {
+		words = append(words, esBelow1000(n%1000))
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+func esCardinal(n *big.Int) (r string) {
+	if n.Sign() == 0 {
+		r = esUnits[0]
+		return
+	}
+	gg := groups(n, 1e6)
+	var words []string
+	for i := len(gg) - 1; i >= 0; i-- {
+		switch {
+		case gg[i] == 0:
+		case i == 0:
+			words = append(words, esBelowMillion(gg[i]))
+		case gg[i] == 1:
+			words = append(words, "un", esScales[i][0])
+		default:
+			words = append(words, esApocope(esBelowMillion(gg[i])), esScales[i][1])
+		}
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+func esOrdBelow1000(n int64) (r string) {
+	var words []string
+	for _, w := range []string{esOrdHundreds[n/100], esOrdTens[n/10%10], esOrdUnits[n%10]} {
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+// returns the prefix of a compound ordinal (eg. 2 ==>> "dos", as in "dosmilésimo")
+func esOrdPrefix(n int64) (r string) {
+	if n > 1 {
+		r = fold(strings.ReplaceAll(esApocope(esBelow1000(n)), " ", ""))
+	}
+	return
+}
+
+func esOrdinal(n *big.Int) (r string, e error) {
+	if n.Cmp(esOrdinalLimit) >= 0 {
+		e = fmt.Errorf("no Spanish ordinal for [ %v ]", n)
+		return
+	}
+	v := n.Int64()
+	var words []string
+	if m := v / 1e6; m > 0 {
+		words = append(words, esOrdPrefix(m)+"millonésimo")
+	}
+	if t := v / 1000 % 1000; t > 0 {
+		words = append(words, esOrdPrefix(t)+"milésimo")
+	}
+	if v%1000 > 0 {
+		words = append(words, esOrdBelow1000(v%1000))
+	}
+	r = strings.Join(words, " ")
+	return
+}
+
+// returns the masculine and feminine forms of the specified ordinal
+func esGenders(w string) (r []string) {
+	r = []string{w, strings.TrimSuffix(w, "o") + "a"}
+	return
+}
+
+var spanish = func() (r *language) {
+	r = &language{code: "es", minus: "menos", space: " ", cardinal: esCardinal, ordinal: esOrdinal, words: lexicon{},
+		bare: map[int64]bool{1000: true}, bareOrdinals: true, ordinalSegments: true}
+	r.words.add(kindFiller, 0, false, "y", "-", ",")
+	r.words.add(kindMinus, 0, false, "menos")
+	for i, w := range esUnits {
+		r.words.add(kindUnit, int64(i), false, w)
+	}
+	r.words.add(kindUnit, 1, false, "un", "una")
+	r.words.add(kindUnit, 21, false, "veintiún", "veintiuna")
+	for i, w := range esTens[3:] {
+		r.words.add(kindUnit, int64(i+3)*10, false, w)
+	}
+	r.words.add(kindUnit, 100, false, "cien")
+	r.words.add(kindUnit, 100, false, esHundreds[1])
+	for i, w := range esHundreds[2:] {
+		r.words.add(kindUnit, int64(i+2)*100, false, w, strings.TrimSuffix(w, "os")+"as")
+	}
+	r.words.add(kindScale, 1000, false, "mil")
+	scale := big.NewInt(1)
+	for _, w := range esScales[1:] {
+		scale = new(big.Int).Mul(scale, big.NewInt(1e6))
+		r.words.addBig(kindScale, scale, false, w[0], w[1])
+	}
+
+	for i := 1; i < 10; i++ {
+		r.words.add(kindUnit, int64(i), true, esGenders(esOrdUnits[i])...)
+		r.words.add(kindUnit, int64(i)*10, true, esGenders(esOrdTens[i])...)
+		r.words.add(kindUnit, int64(i)*100, true, esGenders(esOrdHundreds[i])...)
+	}
+	r.words.add(kindUnit, 1, true, "primer")
+	r.words.add(kindUnit, 3, true, "tercer")
+	r.words.add(kindUnit, 11, true, esGenders("undécimo")...)
+	r.words.add(kindUnit, 12, true, esGenders("duodécimo")...)
+	r.words.add(kindScale, 1000, true, esGenders("milésimo")...)
+	r.words.add(kindScale, 1e6, true, esGenders("millonésimo")...)
+	return
+}()
@@ -0,0 +1,215 @@
+package xl8r
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// limits imposed on the calls made to a codec
+//   - the zero value imposes no limits
+type Policy struct {
+	// maximum execution time of a single Encode, Decode or Evaluate call
+	//   - zero or less means no limit
+	Timeout time.Duration
+	// maximum number of concurrent calls to the codec
+	//   - additional calls fail immediately, with a *CodecBusyError
+	//   - zero or less means no limit
+	MaxConcurrent int
+	// optional settings for retrying failed calls
+	Retry RetryPolicy
+	// optional settings for the circuit breaker
+	Breaker BreakerPolicy
+}
+
+// the error returned when a codec call exceeds the Timeout of its Policy
+type CodecTimeoutError struct {
+	// name of the codec that timed out
+	Codec string
+	// the stage of the translation, during which the timeout occurred
+	Stage Stage
+	// the limit that was exceeded
+	Timeout time.Duration
+}
+
+func (e *CodecTimeoutError) Error() string {
+	return fmt.Sprintf("codec timeout [ '%s' %s ]: exceeded %v", e.Codec, e.Stage, e.Timeout)
+}
+
+// the error returned when a codec is already running MaxConcurrent calls
+type CodecBusyError struct {
+	// name of the busy codec
+	Codec string
+	// the stage of the translation, that was refused
+	Stage Stage
+	// the limit that was reached
+	Limit int
+}
+
+func (e *CodecBusyError) Error() string {
+	return fmt.Sprintf("codec busy [ '%s' %s ]: %d concurrent calls", e.Codec, e.Stage, e.Limit)
+}
+
+// enforces the Policy of a single codec
+type limiter struct {
+	policy Policy
+	// semaphore, holding one token per running call
+	sem chan struct{}
+}
+
+func newLimiter(p Policy) (r *limiter) {
+	if p.Timeout <= 0 && p.MaxConcurrent <= 0 {
+		return
+	}
+	r = &limiter{policy: p}
+	if p.MaxConcurrent > 0 {
+		r.sem = make(chan struct{}, p.MaxConcurrent)
+	}
+	return
+}
+
+func (l *limiter) acquire() (b bool) {
+	if l.sem == nil {
+		b = true
+		return
+	}
+	select {
+	case l.sem <- struct{}{}:
+		b = true
+	default:
+	}
+	return
+}
+
+func (l *limiter) release() {
+	if l.sem != nil {
+		<-l.sem
+	}
+}
+
+func newLimiters(policies map[string]Policy) (r map[string]*limiter) {
+	r = make(map[string]*limiter)
+	for name, p := range policies {
+		if l := newLimiter(p); l != nil {
+			r[name] = l
+		}
+	}
+	return
+}
+
+// looks up the entry for the named codec, in a map keyed by codec names
+//   - an entry for the exact name (eg. "kana@2") takes precedence
+//   - otherwise, the entry for the base name (eg. "kana") applies to all of its versions
+func policyLookup[T any](m map[string]T, name string) (r T, b bool) {
+	if r, b = m[name]; !b {
+		base, _ := splitVersion(name)
+		r, b = m[base]
+	}
+	return
+}
+
+// the outcome of a codec call, run on a separate goroutine
+type outcome[T any] struct {
+	v          T
+	e          error
+	panicked   bool
+	panicVal   any
+	panicStack []byte
+}
+
+// calls f on behalf of the named codec, honoring its Policy and the SafeMode setting
+//   - evaluations are neither retried, nor seen by the circuit breaker,
+//     so that Origins(..) does not reset failures, nor take the trial call of a half-open circuit
+func invoke[P, H, T any](x *convertr[P, H], name string, stage Stage, f func() (T, error)) (r T, e error) {
+	if stage == StageEvaluate {
+		r, e = limit(x, name, stage, f)
+		return
+	}
+	p, _ := policyLookup(x.cfg.Policies, name)
+	retry := p.Retry
+	b, _ := policyLookup(x.breakers, name)
+	for attempt := 1; ; attempt++ {
+		if b != nil {
+			if e = b.allow(name, stage); e != nil {
+				return
+			}
+		}
+		if b != nil {
+			r, e = recordCall(b, func() (T, error) { return limit(x, name, stage, f) })
+		} else {
+			r, e = limit(x, name, stage, f)
+		}
+		if e == nil || attempt >= retry.MaxAttempts || !IsTemporary(e) {
+			return
+		}
+		if backoff := retry.Backoff; backoff != nil {
+			x.clock().Sleep(backoff(attempt))
+		}
+	}
+}
+
+// makes a single call to f, honoring the limits of the named codec's Policy
+func limit[P, H, T any](x *convertr[P, H], name string, stage Stage, f func() (T, error)) (r T, e error) {
+	l, _ := policyLookup(x.limiters, name)
+	if l == nil {
+		r, e = guard(x, name, stage, f)
+		return
+	}
+
+	if !l.acquire() {
+		e = &CodecBusyError{Codec: name, Stage: stage, Limit: l.policy.MaxConcurrent}
+		return
+	}
+
+	if l.policy.Timeout <= 0 {
+		defer l.release()
+		r, e = guard(x, name, stage, f)
+		return
+	}
+
+	// the semaphore token is held until the codec call actually returns,
+	// so that calls which outlive their timeout still count against the limit
+	//   - the outcome is handed over, unless the caller has gone (ie. timed out),
+	//     in which case a panic is reported, as nobody is left to receive it
+	done, gone := make(chan outcome[T]), make(chan struct{})
+	go func() {
+		var o outcome[T]
+		defer func() {
+			if v := recover(); v != nil {
+				o.panicked, o.panicVal, o.panicStack = true, v, debug.Stack()
+			}
+			l.release()
+			select {
+			case done <- o:
+			case <-gone:
+				if o.panicked {
+					x.reportPanic(name, stage, o.panicVal, o.panicStack)
+				}
+			}
+		}()
+		o.v, o.e = guard(x, name, stage, f)
+	}()
+
+	timer := time.NewTimer(l.policy.Timeout)
+	defer timer.Stop()
+	select {
+	case o := <-done:
+		if o.panicked {
+			panic(o.panicVal) // not in SafeMode, so hand the panic to the caller
+		}
+		r, e = o.v, o.e
+	case <-timer.C:
+		close(gone)
+		e = &CodecTimeoutError{Codec: name, Stage: stage, Timeout: l.policy.Timeout}
+	}
+	return
+}
+
+// calls f, recovering any panic when the Interpreter is in SafeMode
+func guard[P, H, T any](x *convertr[P, H], name string, stage Stage, f func() (T, error)) (r T, e error) {
+	if x.cfg.SafeMode {
+		defer x.recoverPanic(name, stage, &e)
+	}
+	r, e = f()
+	return
+}
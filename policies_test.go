@@ -0,0 +1,180 @@
+package xl8r
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+// a codec whose encoder blocks, until the specified channel is closed
+func testBlockingSpoke(id string, unblock <-chan struct{}, started chan<- struct{}) *Spoke[string, string] {
+	return &Spoke[string, string]{
+		Id: id,
+		Enc: func(v string, _ ...Opts) (r string, e error) {
+			if started != nil {
+				started <- struct{}{}
+			}
+			<-unblock
+			r = v
+			return
+		},
+		Dec: func(v string, _ ...Opts) (r string, e error) {
+			r = v
+			return
+		},
+		Check: func(v string) bool { return true },
+	}
+}
+
+func TestPolicyTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	defer close(unblock)
+
+	translate, err := NewWithConfig[string, string](Config{
+		Policies: map[string]Policy{"slow": {Timeout: 20 * time.Millisecond}},
+	}, testBlockingSpoke("slow", unblock, nil), testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	_, tErr := translate.To("calm", "slow", "x")
+	var timeoutErr *CodecTimeoutError
+	assrtTrue(t, errors.As(tErr, &timeoutErr))
+	if timeoutErr != nil {
+		assrtEqual(t, "slow", timeoutErr.Codec)
+		assrtEqual(t, StageEncode, timeoutErr.Stage)
+		assrtEqual(t, 20*time.Millisecond, timeoutErr.Timeout)
+	}
+	t.Log(tErr)
+
+	// codecs without a policy are unaffected
+	result, tErr := translate.To("slow", "calm", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "x", result)
+}
+
+func TestPolicyMaxConcurrent(t *testing.T) {
+	unblock := make(chan struct{})
+	started := make(chan struct{})
+
+	translate, err := NewWithConfig[string, string](Config{
+		Policies: map[string]Policy{"slow": {MaxConcurrent: 2}},
+	}, testBlockingSpoke("slow", unblock, started), testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, tErr := translate.Encode("slow", "x")
+			assrtNil(t, tErr)
+		}()
+		<-started
+	}
+
+	_, tErr := translate.Encode("slow", "x")
+	var busyErr *CodecBusyError
+	assrtTrue(t, errors.As(tErr, &busyErr))
+	if busyErr != nil {
+		assrtEqual(t, "slow", busyErr.Codec)
+		assrtEqual(t, 2, busyErr.Limit)
+	}
+	t.Log(tErr)
+
+	// once the running calls return, the codec accepts calls again
+	close(unblock)
+	wg.Wait()
+	go func() { <-started }()
+	_, tErr = translate.Encode("slow", "x")
+	assrtNil(t, tErr)
+}
+
+func TestPolicyTimeoutHoldsSlot(t *testing.T) {
+	unblock := make(chan struct{})
+
+	translate, err := NewWithConfig[string, string](Config{
+		Policies: map[string]Policy{"slow": {Timeout: 10 * time.Millisecond, MaxConcurrent: 1}},
+	}, testBlockingSpoke("slow", unblock, nil), testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	_, tErr := translate.Encode("slow", "x")
+	var timeoutErr *CodecTimeoutError
+	assrtTrue(t, errors.As(tErr, &timeoutErr))
+
+	// the timed out call is still running, so it still counts against the limit
+	_, tErr = translate.Encode("slow", "x")
+	var busyErr *CodecBusyError
+	assrtTrue(t, errors.As(tErr, &busyErr))
+	close(unblock)
+}
+
+func TestPolicyTimeoutPanics(t *testing.T) {
+	policies := map[string]Policy{"enc": {Timeout: time.Second}}
+
+	safe, err := NewWithConfig[string, string](Config{SafeMode: true, Policies: policies},
+		testPanickySpoke("enc", StageEncode), testPanickySpoke("calm"))
+	assrtNil(t, err)
+	_, tErr := safe.Encode("enc", "x")
+	var panicErr *CodecPanicError
+	assrtTrue(t, errors.As(tErr, &panicErr))
+
+	unsafe, err := NewWithConfig[string, string](Config{Policies: policies},
+		testPanickySpoke("enc", StageEncode), testPanickySpoke("calm"))
+	assrtNil(t, err)
+	defer func() {
+		assrtEqual(t, "encoder blew up", recover())
+	}()
+	unsafe.Encode("enc", "x")
+	failTest(t, "expected the codec panic to reach the caller")
+}
+
+func TestPolicyTimeoutLatePanic(t *testing.T) {
+	unblock := make(chan struct{})
+	observed := make(chan *CodecPanicError, 1)
+	buf := &bytes.Buffer{}
+
+	late := &Spoke[string, string]{
+		Id: "late",
+		Enc: func(v string, _ ...Opts) (r string, e error) {
+			<-unblock
+			panic("encoder blew up late")
+		},
+		Dec: func(v string, _ ...Opts) (r string, e error) {
+			r = v
+			return
+		},
+		Check: func(v string) bool { return true },
+	}
+	translate, err := NewWithConfig[string, string](Config{
+		Logger:   testLogger(buf, slog.LevelError),
+		OnPanic:  func(e *CodecPanicError) { observed <- e },
+		Policies: map[string]Policy{"late": {Timeout: 10 * time.Millisecond}},
+	}, late, testPanickySpoke("calm"))
+	assrtNil(t, err)
+
+	_, tErr := translate.Encode("late", "x")
+	var timeoutErr *CodecTimeoutError
+	assrtTrue(t, errors.As(tErr, &timeoutErr))
+
+	// the panic raised after the timeout is neither lost, nor raised on a goroutine of its own
+	close(unblock)
+	select {
+	case panicErr := <-observed:
+		assrtEqual(t, "late", panicErr.Codec)
+		assrtEqual(t, StageEncode, panicErr.Stage)
+		assrtEqual(t, "encoder blew up late", panicErr.Value)
+		assrtNotNil(t, panicErr.Stack)
+	case <-time.After(time.Second):
+		failTest(t, "expected the late panic to be observed")
+		return
+	}
+	records := testLogRecords(t, buf)
+	assrtEqual(t, 1, len(records))
+	for _, record := range records {
+		assrtEqual(t, "ERROR", record["level"])
+		assrtEqual(t, "late", record["codec"])
+		assrtEqual(t, "encode", record["stage"])
+	}
+}
@@ -0,0 +1,206 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// a builder of codecs, that parse content with a regular expression having named capture groups
+//   - Hub builds the hub data value, from the named groups of the match (ie. the encoder)
+//   - Format (or else Template) produces content, from a hub data value (ie. the decoder)
+//   - the built codec evaluates content, by matching it against the Pattern
+//
+// eg.
+//
+//	spoke, err := (&RegexCodec[string, time.Duration]{
+//		Id:      "hh:mm",
+//		Pattern: `^(?P<hh>\d+):(?P<mm>\d{2})$`,
+//		Hub: func(g *Groups) (time.Duration, error) {
+//			return time.Duration(g.Int("hh"))*time.Hour + time.Duration(g.Int("mm"))*time.Minute, nil
+//		},
+//		Format: func(v time.Duration, _ ...Opts) (string, error) {
+//			return fmt.Sprintf("%d:%02d", int(v.Hours()), int(v.Minutes())%60), nil
+//		},
+//	}).Build()
+type RegexCodec[P ~string, H any] struct {
+	// name of the codec
+	Id string
+	// the regular expression, with at least one named capture group
+	Pattern string
+	// function that builds the hub data value, from the named groups of a match
+	//   - a failed Groups accessor (eg. g.Int("hh")) fails the encoding,
+	//     with a *GroupError naming the group, even if Hub itself returns no error
+	Hub func(g *Groups) (r H, e error)
+	// optional function that converts hub data into content
+	//   - takes precedence over Template
+	Format Decoder[H, P]
+	// optional text/template, executed with the hub data value, that produces content
+	Template string
+	// optional functions, made available to the Template
+	Funcs template.FuncMap
+}
+
+// builds the codec
+//   - returns an error for an invalid name, an invalid Pattern (or one without named groups),
+//     a nil Hub function, an invalid Template, or when neither Format nor Template are set
+func (x *RegexCodec[P, H]) Build() (r *Spoke[P, H], e error) {
+	var errs []error
+	if len(x.Id) == 0 || !versionIsValid(x.Id) {
+		errs = append(errs, fmt.Errorf("invalid codec name"))
+	}
+	re, err := regexp.Compile(x.Pattern)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid pattern -- %w", err))
+	} else if len(strings.Join(re.SubexpNames(), "")) == 0 {
+		errs = append(errs, fmt.Errorf("pattern has no named capture groups"))
+	}
+	if x.Hub == nil {
+		errs = append(errs, fmt.Errorf("nil hub function"))
+	}
+	decode := x.Format
+	if decode == nil {
+		if len(x.Template) == 0 {
+			errs = append(errs, fmt.Errorf("neither a format function, nor a template"))
+		} else if tmpl, err := template.New(x.Id).Funcs(x.Funcs).Parse(x.Template); err != nil {
+			errs = append(errs, fmt.Errorf("invalid template -- %w", err))
+		} else {
+			decode = func(v H, _ ...Opts) (r P, e error) {
+				buf := &strings.Builder{}
+				if e = tmpl.Execute(buf, v); e == nil {
+					r = P(buf.String())
+				}
+				return
+			}
+		}
+	}
+	if err = errors.Join(errs...); err != nil {
+		e = fmt.Errorf("regex codec [ '%s' ]: %w", x.Id, err)
+		return
+	}
+
+	hub := x.Hub
+	r = &Spoke[P, H]{
+		Id: x.Id,
+		Enc: func(v P, _ ...Opts) (r H, e error) {
+			m := re.FindStringSubmatchIndex(string(v))
+			if m == nil {
+				e = fmt.Errorf("could not parse -- %v", v)
+				return
+			}
+			g := &Groups{re: re, content: string(v), match: m}
+			hubData, err := hub(g)
+			if e = g.err; e == nil {
+				e = err
+			}
+			if e == nil {
+				r = hubData
+			}
+			return
+		},
+		Dec:   decode,
+		Check: func(v P) bool { return re.MatchString(string(v)) },
+	}
+	return
+}
+
+// the error returned when a named group of a match cannot be converted
+type GroupError struct {
+	// name of the capture group
+	Group string
+	// the text captured by the group
+	Value string
+	Err   error
+}
+
+func (e *GroupError) Error() string {
+	return fmt.Sprintf("invalid group [ '%s' ]: '%s' -- %v", e.Group, e.Value, e.Err)
+}
+
+func (e *GroupError) Unwrap() error {
+	return e.Err
+}
+
+// the named capture groups of a single match
+//   - accessors for groups that did not take part in the match return zero values
+//   - the first failed accessor is remembered, and reported by Err()
+type Groups struct {
+	re      *regexp.Regexp
+	content string
+	match   []int
+	err     error
+}
+
+// returns bool true, if the named group took part in the match
+func (g *Groups) Has(name string) (r bool) {
+	_, r = g.text(name)
+	return
+}
+
+// returns the text captured by the named group
+func (g *Groups) String(name string) (r string) {
+	r, _ = g.text(name)
+	return
+}
+
+// returns the text captured by the named group, as an int
+func (g *Groups) Int(name string) (r int) {
+	if s, ok := g.text(name); ok {
+		var err error
+		if r, err = strconv.Atoi(s); err != nil {
+			g.fail(name, s, err)
+		}
+	}
+	return
+}
+
+// returns the text captured by the named group, as a float64
+func (g *Groups) Float(name string) (r float64) {
+	if s, ok := g.text(name); ok {
+		var err error
+		if r, err = strconv.ParseFloat(s, 64); err != nil {
+			g.fail(name, s, err)
+		}
+	}
+	return
+}
+
+// returns the texts captured by all named groups, that took part in the match
+func (g *Groups) Values() (r map[string]string) {
+	r = make(map[string]string)
+	for _, name := range g.re.SubexpNames() {
+		if len(name) == 0 {
+			continue
+		}
+		if s, ok := g.text(name); ok {
+			r[name] = s
+		}
+	}
+	return
+}
+
+// returns the first error of a failed accessor (as a *GroupError), if any
+func (g *Groups) Err() error {
+	return g.err
+}
+
+func (g *Groups) text(name string) (r string, ok bool) {
+	i := g.re.SubexpIndex(name)
+	if len(name) == 0 || i < 0 {
+		g.fail(name, "", fmt.Errorf("unknown group"))
+		return
+	}
+	if start, end := g.match[2*i], g.match[2*i+1]; start >= 0 {
+		r, ok = g.content[start:end], true
+	}
+	return
+}
+
+func (g *Groups) fail(name, value string, err error) {
+	if g.err == nil {
+		g.err = &GroupError{Group: name, Value: value, Err: err}
+	}
+}
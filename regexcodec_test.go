@@ -0,0 +1,176 @@
+package xl8r
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func testHoursMinutesCodec(t *testing.T) *Spoke[string, time.Duration] {
+	t.Helper()
+	spoke, err := (&RegexCodec[string, time.Duration]{
+		Id:      "hh:mm",
+		Pattern: `^(?P<hh>\d+):(?P<mm>\d{2})(?::(?P<ss>\d{2}))?$`,
+		Hub: func(g *Groups) (r time.Duration, e error) {
+			r = time.Duration(g.Int("hh"))*time.Hour + time.Duration(g.Int("mm"))*time.Minute
+			if g.Has("ss") {
+				r += time.Duration(g.Int("ss")) * time.Second
+			}
+			return
+		},
+		Template: `{{hours .}}:{{minutes .}}`,
+		Funcs: map[string]any{
+			"hours":   func(d time.Duration) int { return int(d.Hours()) },
+			"minutes": func(d time.Duration) string { return strconv.Itoa(100 + int(d.Minutes())%60)[1:] },
+		},
+	}).Build()
+	assrtNil(t, err)
+	return spoke
+}
+
+func TestRegexCodec(t *testing.T) {
+	hhmm := testHoursMinutesCodec(t)
+	minutes, err := (&RegexCodec[string, time.Duration]{
+		Id:      "minutes",
+		Pattern: `^(?P<min>\d+(?:\.\d+)?) ?min$`,
+		Hub: func(g *Groups) (r time.Duration, e error) {
+			r = time.Duration(g.Float("min") * float64(time.Minute))
+			return
+		},
+		Format: func(v time.Duration, _ ...Opts) (r string, e error) {
+			r = strconv.FormatFloat(v.Minutes(), 'f', -1, 64) + " min"
+			return
+		},
+	}).Build()
+	assrtNil(t, err)
+
+	translate, err := New[string, time.Duration](hhmm, minutes)
+	assrtNil(t, err)
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "minutes", from: "hh:mm", text: "1:30", expected: "90 min"},
+		{to: "minutes", from: "hh:mm", text: "0:01:30", expected: "1.5 min"},
+		{to: "hh:mm", from: "minutes", text: "150min", expected: "2:30"},
+		{to: "hh:mm", from: "minutes", text: "65.5 min", expected: "1:05"},
+		{to: "hh:mm", from: "minutes", text: "1:30", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := translate.To(tx.to, tx.from, tx.text)
+		assrtEqual(t, tx.expected, result)
+		assrtEqual(t, tx.expectedErr, tErr != nil)
+		t.Logf(`# %d: To("%s","%s","%s") ==>> "%s" %v`, i, tx.to, tx.from, tx.text, result, tErr)
+	}
+
+	assrtEqual(t, []string{"minutes"}, translate.Origins("3 min"))
+	assrtEqual(t, 0, len(translate.Origins("3 mins")))
+}
+
+func TestRegexCodecGroupErrors(t *testing.T) {
+	spoke, err := (&RegexCodec[string, int]{
+		Id:      "sum",
+		Pattern: `^(?P<a>\d+)\+(?P<b>\d+)$`,
+		Hub: func(g *Groups) (r int, e error) {
+			r = g.Int("a") + g.Int("b")
+			return
+		},
+		Template: `{{.}}`,
+	}).Build()
+	assrtNil(t, err)
+
+	result, eErr := spoke.Encode("1+2")
+	assrtNil(t, eErr)
+	assrtEqual(t, 3, result)
+
+	// the hub data built from a failed accessor is not returned
+	result, eErr = spoke.Encode("1+99999999999999999999")
+	assrtEqual(t, 0, result)
+	var groupErr *GroupError
+	assrtTrue(t, errors.As(eErr, &groupErr))
+	assrtEqual(t, "b", groupErr.Group)
+	assrtEqual(t, "99999999999999999999", groupErr.Value)
+	assrtTrue(t, errors.Is(eErr, strconv.ErrRange))
+	t.Log(eErr)
+
+	typo, err := (&RegexCodec[string, int]{
+		Id:       "typo",
+		Pattern:  `^(?P<a>\d+)$`,
+		Hub:      func(g *Groups) (r int, e error) { r = g.Int("A"); return },
+		Template: `{{.}}`,
+	}).Build()
+	assrtNil(t, err)
+	_, eErr = typo.Encode("1")
+	assrtTrue(t, errors.As(eErr, &groupErr))
+	assrtEqual(t, "A", groupErr.Group)
+
+	// a failed accessor takes precedence over the error returned by Hub
+	failing, err := (&RegexCodec[string, int]{
+		Id:      "failing",
+		Pattern: `^(?P<a>\d+)$`,
+		Hub: func(g *Groups) (r int, e error) {
+			r, e = g.Int("A")+1, errors.New("no such group")
+			return
+		},
+		Template: `{{.}}`,
+	}).Build()
+	assrtNil(t, err)
+	result, eErr = failing.Encode("1")
+	assrtEqual(t, 0, result)
+	assrtTrue(t, errors.As(eErr, &groupErr))
+	assrtEqual(t, "A", groupErr.Group)
+}
+
+func TestRegexCodecBuildErrors(t *testing.T) {
+	hub := func(g *Groups) (r int, e error) { return }
+	tt := []*RegexCodec[string, int]{
+		{Pattern: `(?P<n>\d)`, Hub: hub, Template: `{{.}}`},
+		{Id: "n", Pattern: `(`, Hub: hub, Template: `{{.}}`},
+		{Id: "n", Pattern: `(\d)`, Hub: hub, Template: `{{.}}`},
+		{Id: "n", Pattern: `(?P<n>\d)`, Template: `{{.}}`},
+		{Id: "n", Pattern: `(?P<n>\d)`, Hub: hub},
+		{Id: "n", Pattern: `(?P<n>\d)`, Hub: hub, Template: `{{.`},
+	}
+
+	for i, x := range tt {
+		spoke, err := x.Build()
+		assrtNotNil(t, err)
+		assrtTrue(t, spoke == nil)
+		t.Logf("# %d: %v", i, err)
+	}
+}
+
+// a RegexCodec, built to match the hand-written "hh:mm:ss" codec of the duration format tests
+func TestRegexCodecMatchesSpoke(t *testing.T) {
+	spoke := fetchColonDlmHMSCodec()
+	built, err := (&RegexCodec[durationValue, *durationHubData]{
+		Id:      "hh:mm:ss",
+		Pattern: `(?P<hh>[\d]+)\s*:\s*(?P<mm>[\d]{1,2})\s*:\s*(?P<ss>[\d]{1,2})`,
+		Hub: func(g *Groups) (r *durationHubData, e error) {
+			r = newDurationHubData(&durationParams{H: g.Float("hh"), M: g.Float("mm"), S: g.Float("ss")})
+			return
+		},
+		Format: spoke.Decode,
+	}).Build()
+	assrtNil(t, err)
+
+	for _, content := range []durationValue{"1:02:03", "26 : 5 : 7", "0:0:59", "1:02", "1h 2m 3s"} {
+		assrtEqual(t, spoke.Evaluate(content), built.Evaluate(content), "Evaluate(%s)", content)
+		if !spoke.Evaluate(content) {
+			continue
+		}
+		expected, err := spoke.Encode(content)
+		assrtNil(t, err)
+		hubData, err := built.Encode(content)
+		assrtNil(t, err)
+		assrtEqual(t, expected.TotalSeconds(), hubData.TotalSeconds(), "Encode(%s)", content)
+
+		expectedText, _ := spoke.Decode(hubData)
+		text, err := built.Decode(hubData)
+		assrtNil(t, err)
+		assrtEqual(t, expectedText, text, "Decode(%s)", content)
+	}
+}
@@ -0,0 +1,160 @@
+package xl8r
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// the package-level registry of codecs, keyed by domain and codec name
+//   - codec packs register their codecs from init(), so that an application
+//     only needs to (blank) import a pack, to make its codecs available
+var registry = struct {
+	mu      sync.RWMutex
+	domains map[string]*domain
+}{domains: make(map[string]*domain)}
+
+// the codecs registered under a single domain
+type domain struct {
+	// the Codec[P, H] type shared by all entries of the domain
+	codecType reflect.Type
+	// each entry is either a Codec[P, H] or a CodecFactory[P, H]
+	entries map[string]any
+}
+
+// makes the specified codec available under the specified domain
+//   - the codec is shared by all Interpreters built from the domain
+//     (see RegisterFactory, for codecs that hold resources)
+//   - panics if the codec is invalid, if its name (or version, in another spelling) is already registered under the domain,
+//     or if the domain holds codecs of different content or hub data types
+func RegisterCodec[P, H any](domain string, c Codec[P, H]) {
+	if !codecIsValid(c) {
+		panic(fmt.Sprintf("xl8r: RegisterCodec of invalid codec in domain '%s'", domain))
+	}
+	registerEntry[P, H](domain, c.Name(), c)
+}
+
+// makes a codec available under the specified domain and name
+//   - each Interpreter built from the domain constructs its own codec,
+//     using the factory on first use (see Lazy)
+//   - panics if the factory is nil, if the name is invalid or already registered under the domain (in any spelling of its version),
+//     or if the domain holds codecs of different content or hub data types
+func RegisterFactory[P, H any](domain, name string, factory CodecFactory[P, H]) {
+	if factory == nil || len(name) == 0 || !versionIsValid(name) {
+		panic(fmt.Sprintf("xl8r: RegisterFactory of invalid codec '%s' in domain '%s'", name, domain))
+	}
+	registerEntry[P, H](domain, name, factory)
+}
+
+func registerEntry[P, H any](name, codecName string, entry any) {
+	codecType := reflect.TypeOf((*Codec[P, H])(nil)).Elem()
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	d, exists := registry.domains[name]
+	if !exists {
+		d = &domain{codecType: codecType, entries: make(map[string]any)}
+		registry.domains[name] = d
+	}
+	if d.codecType != codecType {
+		panic(fmt.Sprintf("xl8r: domain '%s' holds codecs of type %v, not %v", name, d.codecType, codecType))
+	}
+	if _, dup := d.entries[codecName]; dup {
+		panic(fmt.Sprintf("xl8r: codec '%s' registered twice in domain '%s'", codecName, name))
+	}
+	for other := range d.entries {
+		if sameVersion(codecName, other) {
+			panic(fmt.Sprintf("xl8r: codec '%s' registered twice in domain '%s', as '%s'", codecName, name, other))
+		}
+	}
+	d.entries[codecName] = entry
+}
+
+// returns the sorted names of all domains, with registered codecs
+func Domains() (r []string) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	for name := range registry.domains {
+		r = append(r, name)
+	}
+	sort.Strings(r)
+	return
+}
+
+// returns the sorted names of all codecs, registered under the specified domain
+func DomainCodecs(domain string) (r []string) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	if d, exists := registry.domains[domain]; exists {
+		for name := range d.entries {
+			r = append(r, name)
+		}
+	}
+	sort.Strings(r)
+	return
+}
+
+// creates a new Interpreter instance, from all codecs registered under the specified domain
+func NewFromDomain[P, H any](cfg Config, domain string) (r Interpreter[P, H], e error) {
+	var codecs codecMap[P, H]
+	if codecs, e = domainCodecs[P, H](domain); e != nil {
+		return
+	}
+	names := codecs.keys()
+	sort.Strings(names)
+	all := make([]Codec[P, H], 0, len(codecs))
+	for _, name := range names {
+		all = append(all, codecs[name])
+	}
+	r, e = NewWithConfig(cfg, all...)
+	return
+}
+
+// creates a new Interpreter instance, from the specified codecs registered under the specified domain
+//   - versioned names are resolved as usual (eg. "kana@latest" selects the latest version)
+func NewFromNames[P, H any](cfg Config, domain string, names ...string) (r Interpreter[P, H], e error) {
+	var codecs codecMap[P, H]
+	if codecs, e = domainCodecs[P, H](domain); e != nil {
+		return
+	}
+	chosen := make([]Codec[P, H], 0, len(names))
+	for _, name := range names {
+		c, exists := codecs.resolve(name, nil)
+		if !exists {
+			e = fmt.Errorf("unknown codec [ '%s' ] in domain [ '%s' ]", name, domain)
+			return
+		}
+		chosen = append(chosen, c)
+	}
+	r, e = NewWithConfig(cfg, chosen...)
+	return
+}
+
+// returns the codecs registered under the specified domain,
+// with a new Lazy codec for each registered factory
+func domainCodecs[P, H any](name string) (r codecMap[P, H], e error) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	d, exists := registry.domains[name]
+	if !exists {
+		e = fmt.Errorf("unknown domain [ '%s' ]", name)
+		return
+	}
+	if codecType := reflect.TypeOf((*Codec[P, H])(nil)).Elem(); d.codecType != codecType {
+		e = fmt.Errorf("domain [ '%s' ] holds codecs of type %v, not %v", name, d.codecType, codecType)
+		return
+	}
+	r = make(codecMap[P, H])
+	for codecName, entry := range d.entries {
+		switch x := entry.(type) {
+		case Codec[P, H]:
+			r[codecName] = x
+		case CodecFactory[P, H]:
+			r[codecName] = &Lazy[P, H]{Id: codecName, Factory: x}
+		}
+	}
+	return
+}
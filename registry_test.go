@@ -0,0 +1,80 @@
+package xl8r
+
+import (
+	"fmt"
+	"testing"
+)
+
+// a codec pack, registering its codecs from init() ...
+func init() {
+	for _, c := range definedLangTestCodecs {
+		RegisterCodec("test/lang", c)
+	}
+	RegisterCodec[string, string]("test/tags", testTaggingSpoke("kana@1", "a"))
+	RegisterCodec[string, string]("test/tags", testTaggingSpoke("kana@2", "a"))
+	RegisterFactory("test/tags", "romaji", func() (Codec[string, string], error) {
+		return testTaggingSpoke("romaji", "r"), nil
+	})
+}
+
+func testPanics(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		v := recover()
+		assrtNotNil(t, v)
+		t.Log(v)
+	}()
+	f()
+}
+
+func TestRegistryDomains(t *testing.T) {
+	domains := Domains()
+	assrtTrue(t, len(domains) >= 2)
+	assrtEqual(t, []string{"english", "ga", "haitian creole", "hawaiian", "japanese", "klingon", "spanish"}, DomainCodecs("test/lang"))
+	assrtEqual(t, []string{"kana@1", "kana@2", "romaji"}, DomainCodecs("test/tags"))
+	assrtEqual(t, 0, len(DomainCodecs("test/none")))
+}
+
+func TestNewFromDomain(t *testing.T) {
+	translateLang, err := NewFromDomain[myLanguageContentType, myLanguageHubDataType](Config{}, "test/lang")
+	assrtNil(t, err)
+	result, tErr := translateLang.To("haitian creole", "spanish", "dos cinco uno")
+	assrtNil(t, tErr)
+	assrtEqual(t, myLanguageContentType("de senk en"), result)
+
+	_, err = NewFromDomain[myLanguageContentType, myLanguageHubDataType](Config{}, "test/none")
+	assrtEqual(t, fmt.Errorf("unknown domain [ 'test/none' ]"), err)
+
+	_, err = NewFromDomain[string, string](Config{}, "test/lang")
+	assrtNotNil(t, err)
+	t.Log(err)
+}
+
+func TestNewFromNames(t *testing.T) {
+	translate, err := NewFromNames[string, string](Config{}, "test/tags", "kana", "romaji")
+	assrtNil(t, err)
+	assrtEqual(t, []string{"kana@2", "romaji"}, translate.List(""))
+	result, tErr := translate.To("romaji", "kana", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "romaji(x)", result)
+
+	// each Interpreter constructs its own codec from a registered factory
+	other, err := NewFromNames[string, string](Config{}, "test/tags", "kana@1", "romaji")
+	assrtNil(t, err)
+	lazy1 := translate.(*convertr[string, string]).registered()["romaji"]
+	lazy2 := other.(*convertr[string, string]).registered()["romaji"]
+	assrtFalse(t, lazy1 == lazy2)
+
+	_, err = NewFromNames[string, string](Config{}, "test/tags", "kana", "kanji")
+	assrtEqual(t, fmt.Errorf("unknown codec [ 'kanji' ] in domain [ 'test/tags' ]"), err)
+}
+
+func TestRegistryPanics(t *testing.T) {
+	testPanics(t, func() { RegisterCodec[string, string]("test/tags", testTaggingSpoke("kana@1")) })
+	testPanics(t, func() { RegisterCodec[string, string]("test/tags", &Spoke[string, string]{}) })
+	testPanics(t, func() { RegisterCodec("test/tags", fetchEngCodec()) })
+	testPanics(t, func() { RegisterFactory[string, string]("test/tags", "kanji", nil) })
+	testPanics(t, func() {
+		RegisterFactory("test/tags", "kanji@x", func() (Codec[string, string], error) { return nil, nil })
+	})
+}
@@ -0,0 +1,68 @@
+package xl8r
+
+import (
+	"errors"
+	"time"
+)
+
+// settings for retrying failed codec calls
+//   - only errors marked as temporary are retried (see IsTemporary)
+//   - the zero value disables retries
+type RetryPolicy struct {
+	// maximum number of attempts, including the first one
+	//   - one or less means no retries
+	MaxAttempts int
+	// optional function that returns the delay before the next attempt
+	//   - attempt is the number of the attempt that just failed, starting at 1
+	//   - nil means no delay
+	Backoff func(attempt int) time.Duration
+}
+
+// returns a backoff function that doubles the delay after each attempt,
+// starting at base and never exceeding limit
+func ExponentialBackoff(base, limit time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) (r time.Duration) {
+		r = base
+		for i := 1; i < attempt && r < limit; i++ {
+			r *= 2
+		}
+		if r > limit {
+			r = limit
+		}
+		return
+	}
+}
+
+// an error that is marked as temporary (ie. retryable)
+type temporaryError struct {
+	error
+}
+
+func (e temporaryError) Temporary() bool { return true }
+
+func (e temporaryError) Unwrap() error { return e.error }
+
+// marks the specified error as temporary, so that a RetryPolicy retries it
+//   - returns nil, if the specified error is nil
+func Temporary(e error) (r error) {
+	if e != nil {
+		r = temporaryError{e}
+	}
+	return
+}
+
+// returns bool true, if the specified error (or any error it wraps)
+// has a Temporary() method that returns bool true
+func IsTemporary(e error) (r bool) {
+	var t interface{ Temporary() bool }
+	if errors.As(e, &t) {
+		r = t.Temporary()
+	}
+	return
+}
+
+// a busy codec may accept the next call
+func (e *CodecBusyError) Temporary() bool { return true }
+
+// a codec that timed out may finish the next call in time
+func (e *CodecTimeoutError) Temporary() bool { return true }
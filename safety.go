@@ -0,0 +1,74 @@
+package xl8r
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+)
+
+// the error returned when a codec panics, while the Interpreter is in SafeMode
+type CodecPanicError struct {
+	// name of the codec that panicked
+	Codec string
+	// the stage of the translation, during which the panic occurred
+	Stage Stage
+	// the value passed to panic(..)
+	Value any
+	// the stack trace of the panicking goroutine
+	Stack []byte
+}
+
+func (e *CodecPanicError) Error() string {
+	return fmt.Sprintf("codec panic [ '%s' %s ]: %v", e.Codec, e.Stage, e.Value)
+}
+
+// returns the panic value, if it was an error
+func (e *CodecPanicError) Unwrap() (r error) {
+	r, _ = e.Value.(error)
+	return
+}
+
+// recovers a panic raised by the named codec, during the specified stage
+//   - must be deferred directly
+//   - the recovered panic is stored in e (if non-nil) and reported to the observer
+func (x *convertr[P, H]) recoverPanic(name string, stage Stage, e *error) {
+	if v := recover(); v != nil {
+		err := &CodecPanicError{
+			Codec: name,
+			Stage: stage,
+			Value: v,
+			Stack: debug.Stack(),
+		}
+		if e != nil {
+			*e = err
+		}
+		if observe := x.cfg.OnPanic; observe != nil {
+			observe(err)
+		}
+	}
+}
+
+// reports a panic raised by the named codec, which no caller is left to receive
+//   - ie. a panic raised after the call timed out, while not in SafeMode
+//   - the panic is logged at level ERROR (by slog.Default(), if no Logger is configured)
+//     and reported to the observer
+func (x *convertr[P, H]) reportPanic(name string, stage Stage, v any, stack []byte) {
+	err := &CodecPanicError{
+		Codec: name,
+		Stage: stage,
+		Value: v,
+		Stack: stack,
+	}
+	logger := x.cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.LogAttrs(context.Background(), slog.LevelError, "codec panic after timeout",
+		slog.String("codec", name),
+		slog.String("stage", string(stage)),
+		slog.String("error", err.Error()))
+	if observe := x.cfg.OnPanic; observe != nil {
+		observe(err)
+	}
+}
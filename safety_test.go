@@ -0,0 +1,105 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// a codec that panics in the specified stages
+func testPanickySpoke(id string, stages ...Stage) *Spoke[string, string] {
+	panics := make(map[Stage]bool)
+	for _, stage := range stages {
+		panics[stage] = true
+	}
+	return &Spoke[string, string]{
+		Id: id,
+		Enc: func(v string, _ ...Opts) (r string, e error) {
+			if panics[StageEncode] {
+				panic("encoder blew up")
+			}
+			r = v
+			return
+		},
+		Dec: func(v string, _ ...Opts) (r string, e error) {
+			if panics[StageDecode] {
+				panic(fmt.Errorf("decoder blew up"))
+			}
+			r = v
+			return
+		},
+		Check: func(v string) bool {
+			if panics[StageEvaluate] {
+				var m map[string]bool
+				m[v] = true // nil map assignment
+			}
+			return true
+		},
+	}
+}
+
+func TestSafeModeRecoversPanics(t *testing.T) {
+	var observed []*CodecPanicError
+	cfg := Config{
+		SafeMode: true,
+		OnPanic:  func(e *CodecPanicError) { observed = append(observed, e) },
+	}
+	translate, err := NewWithConfig[string, string](cfg,
+		testPanickySpoke("calm"),
+		testPanickySpoke("enc", StageEncode),
+		testPanickySpoke("dec", StageDecode),
+		testPanickySpoke("eval", StageEvaluate),
+	)
+	assrtNil(t, err)
+
+	tt := []struct {
+		run           func() error
+		expectedCodec string
+		expectedStage Stage
+	}{
+		{run: func() (e error) { _, e = translate.To("calm", "enc", "x"); return }, expectedCodec: "enc", expectedStage: StageEncode},
+		{run: func() (e error) { _, e = translate.To("dec", "calm", "x"); return }, expectedCodec: "dec", expectedStage: StageDecode},
+		{run: func() (e error) { _, e = translate.Encode("enc", "x"); return }, expectedCodec: "enc", expectedStage: StageEncode},
+		{run: func() (e error) { _, e = translate.Decode("dec", "x"); return }, expectedCodec: "dec", expectedStage: StageDecode},
+	}
+
+	for i, tx := range tt {
+		err := tx.run()
+		var panicErr *CodecPanicError
+		assrtTrue(t, errors.As(err, &panicErr))
+		if panicErr == nil {
+			continue
+		}
+		assrtEqual(t, tx.expectedCodec, panicErr.Codec)
+		assrtEqual(t, tx.expectedStage, panicErr.Stage)
+		assrtNotNil(t, panicErr.Value)
+		assrtTrue(t, len(panicErr.Stack) > 0)
+		t.Logf("# %d: %v", i, err)
+	}
+
+	// the decoder panicked with an error value, which is unwrapped
+	_, err = translate.Decode("dec", "x")
+	assrtEqual(t, "decoder blew up", errors.Unwrap(err).Error())
+
+	observed = nil
+	origins := translate.Origins("x")
+	sort.Strings(origins)
+	assrtEqual(t, []string{"calm", "dec", "enc"}, origins)
+	assrtEqual(t, 1, len(observed))
+	assrtEqual(t, "eval", observed[0].Codec)
+	assrtEqual(t, StageEvaluate, observed[0].Stage)
+	assrtTrue(t, strings.Contains(observed[0].Error(), "nil map"))
+}
+
+func TestUnsafeModePanics(t *testing.T) {
+	translate, err := New[string, string](testPanickySpoke("calm"), testPanickySpoke("enc", StageEncode))
+	assrtNil(t, err)
+
+	defer func() {
+		assrtNotNil(t, recover())
+	}()
+	translate.To("calm", "enc", "x")
+	failTest(t, "expected the codec panic to propagate")
+}
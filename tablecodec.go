@@ -0,0 +1,188 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// a builder of lookup-table codecs, that map whole (normalized) content to hub data values
+//   - each hub value has a preferred form, produced when decoding
+//   - any number of synonyms may encode to the same hub value
+//   - content is normalized before lookup (see Normalize and CaseSensitive)
+//
+// eg.
+//
+//	spoke, err := NewTableCodec[string, int]("english").
+//		Add(1, "one", "1", "a single").
+//		Add(2, "two", "2", "a pair").
+//		Build()
+type TableCodec[P ~string, H comparable] struct {
+	// name of the codec
+	Id string
+	// when bool true, content is matched case-sensitively
+	//   - ignored, if Normalize is set
+	CaseSensitive bool
+	// optional function that normalizes content (and table texts) before lookup
+	//   - when nil, surrounding whitespace is trimmed, inner whitespace is collapsed
+	//     to a single space, and (unless CaseSensitive) content is lower-cased
+	Normalize func(string) string
+
+	texts     []tableText[P, H]
+	preferred map[H]P
+	hubs      []H
+	errs      []error
+}
+
+type tableText[P ~string, H comparable] struct {
+	text P
+	hub  H
+}
+
+// creates a new (empty) TableCodec, with the specified name
+func NewTableCodec[P ~string, H comparable](name string) (r *TableCodec[P, H]) {
+	r = &TableCodec[P, H]{Id: name}
+	return
+}
+
+// adds a hub value, with its preferred form and (optional) synonyms
+//   - the preferred form and all synonyms encode to the hub value
+//   - the preferred form is produced when decoding the hub value
+//   - adding the same hub value twice is reported by Build()
+func (t *TableCodec[P, H]) Add(hub H, preferred P, synonyms ...P) *TableCodec[P, H] {
+	if _, exists := t.preferred[hub]; exists {
+		t.errs = append(t.errs, fmt.Errorf("duplicate hub value [ %v ]", hub))
+		return t
+	}
+	t.Prefer(hub, preferred)
+	t.texts = append(t.texts, tableText[P, H]{text: preferred, hub: hub})
+	return t.Synonyms(hub, synonyms...)
+}
+
+// adds synonyms, that encode to the specified hub value
+//   - synonyms are never produced when decoding
+func (t *TableCodec[P, H]) Synonyms(hub H, synonyms ...P) *TableCodec[P, H] {
+	for _, text := range synonyms {
+		t.texts = append(t.texts, tableText[P, H]{text: text, hub: hub})
+	}
+	return t
+}
+
+// sets (or replaces) the preferred form of the specified hub value, produced when decoding
+//   - the preferred form is not added as a synonym (see Unmatched)
+func (t *TableCodec[P, H]) Prefer(hub H, preferred P) *TableCodec[P, H] {
+	if t.preferred == nil {
+		t.preferred = make(map[H]P)
+	}
+	if _, exists := t.preferred[hub]; !exists {
+		t.hubs = append(t.hubs, hub)
+	}
+	t.preferred[hub] = preferred
+	return t
+}
+
+// returns the normalization function in effect
+func (t *TableCodec[P, H]) normalizer() func(string) string {
+	if t.Normalize != nil {
+		return t.Normalize
+	}
+	if t.CaseSensitive {
+		return normalizeSpace
+	}
+	return func(s string) string { return strings.ToLower(normalizeSpace(s)) }
+}
+
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// builds the encoder lookup map, keyed by normalized text
+func (t *TableCodec[P, H]) encoderMap() (r map[string]H, e error) {
+	normalize := t.normalizer()
+	r = make(map[string]H, len(t.texts))
+	var errs []error
+	for _, entry := range t.texts {
+		key := normalize(string(entry.text))
+		if len(key) == 0 {
+			errs = append(errs, fmt.Errorf("empty text for hub value [ %v ]", entry.hub))
+			continue
+		}
+		if hub, exists := r[key]; exists && hub != entry.hub {
+			errs = append(errs, fmt.Errorf("conflicting text [ '%s' ] for hub values [ %v ] and [ %v ]", entry.text, hub, entry.hub))
+			continue
+		}
+		r[key] = entry.hub
+	}
+	e = errors.Join(errs...)
+	return
+}
+
+// returns the hub values (in the order they were added), whose preferred form
+// does not encode back to the same hub value
+//   - ie. decoder entries with no matching encoder entry
+func (t *TableCodec[P, H]) Unmatched() (r []H) {
+	encMap, _ := t.encoderMap()
+	r = t.unmatched(encMap)
+	return
+}
+
+func (t *TableCodec[P, H]) unmatched(encMap map[string]H) (r []H) {
+	normalize := t.normalizer()
+	for _, hub := range t.hubs {
+		if encoded, exists := encMap[normalize(string(t.preferred[hub]))]; !exists || encoded != hub {
+			r = append(r, hub)
+		}
+	}
+	return
+}
+
+// builds the codec
+//   - returns an error for an invalid name, duplicate hub values, empty or conflicting texts,
+//     and for decoder entries with no matching encoder entry (see Unmatched)
+//   - the built codec does not change, when the TableCodec is changed afterwards
+func (t *TableCodec[P, H]) Build() (r *Spoke[P, H], e error) {
+	errs := append([]error(nil), t.errs...)
+	if len(t.Id) == 0 || !versionIsValid(t.Id) {
+		errs = append(errs, fmt.Errorf("invalid codec name"))
+	}
+	if len(t.hubs) == 0 {
+		errs = append(errs, fmt.Errorf("no entries"))
+	}
+	encMap, err := t.encoderMap()
+	errs = append(errs, err)
+	if unmatched := t.unmatched(encMap); len(unmatched) > 0 {
+		errs = append(errs, fmt.Errorf("no encoder entry for the preferred form of hub values %v", unmatched))
+	}
+	if err = errors.Join(errs...); err != nil {
+		e = fmt.Errorf("table codec [ '%s' ]: %w", t.Id, err)
+		return
+	}
+
+	normalize := t.normalizer()
+	decMap := make(map[H]P, len(t.preferred))
+	for hub, text := range t.preferred {
+		decMap[hub] = text
+	}
+	r = &Spoke[P, H]{
+		Id: t.Id,
+		Enc: func(v P, _ ...Opts) (r H, e error) {
+			var exists bool
+			if r, exists = encMap[normalize(string(v))]; !exists {
+				e = fmt.Errorf("unknown text: '%s'", v)
+			}
+			return
+		},
+		Dec: func(v H, _ ...Opts) (r P, e error) {
+			var exists bool
+			if r, exists = decMap[v]; !exists {
+				e = fmt.Errorf("{unknown: %v}", v)
+			}
+			return
+		},
+		Check: func(v P) (r bool) {
+			_, r = encMap[normalize(string(v))]
+			return
+		},
+	}
+	return
+}
@@ -0,0 +1,111 @@
+package xl8r
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableCodec(t *testing.T) {
+	english, err := NewTableCodec[string, int]("english").
+		Add(1, "one", "1", "a single").
+		Add(2, "two", "2", "a  pair").
+		Add(3, "three", "3").
+		Build()
+	assrtNil(t, err)
+	spanish, err := NewTableCodec[string, int]("spanish").
+		Add(1, "uno", "una").
+		Add(2, "dos").
+		Add(3, "tres").
+		Build()
+	assrtNil(t, err)
+
+	translate, err := New[string, int](english, spanish)
+	assrtNil(t, err)
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "spanish", from: "english", text: "one", expected: "uno"},
+		{to: "spanish", from: "english", text: "  A Single ", expected: "uno"},
+		{to: "spanish", from: "english", text: "a pair", expected: "dos"},
+		{to: "spanish", from: "english", text: "a\tPAIR", expected: "dos"},
+		{to: "english", from: "spanish", text: "una", expected: "one"},
+		{to: "english", from: "spanish", text: "cuatro", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := translate.To(tx.to, tx.from, tx.text)
+		assrtEqual(t, tx.expected, result)
+		assrtEqual(t, tx.expectedErr, tErr != nil)
+		t.Logf(`# %d: To("%s","%s","%s") ==>> "%s" %v`, i, tx.to, tx.from, tx.text, result, tErr)
+	}
+
+	assrtEqual(t, []string{"english"}, translate.Origins("3"))
+	_, tErr := translate.Decode("english", 4)
+	assrtEqual(t, "{unknown: 4}", tErr.Error())
+}
+
+func TestTableCodecNormalize(t *testing.T) {
+	table := NewTableCodec[myLanguageContentType, int]("klingon").
+		Add(1, "wa'", "wa’").
+		Add(4, "loS")
+	table.CaseSensitive = true
+	klingon, err := table.Build()
+	assrtNil(t, err)
+	assrtTrue(t, klingon.Evaluate("loS"))
+	assrtFalse(t, klingon.Evaluate("los"))
+
+	table.Normalize = func(s string) string { return strings.ReplaceAll(strings.ToLower(s), "’", "'") }
+	custom, err := table.Build()
+	assrtNil(t, err)
+	assrtTrue(t, custom.Evaluate("LOS"))
+	assrtFalse(t, custom.Evaluate(" los"))
+
+	// a built codec is not changed by later changes to its table
+	assrtFalse(t, klingon.Evaluate("los"))
+}
+
+func TestTableCodecUnmatched(t *testing.T) {
+	table := NewTableCodec[string, int]("english").
+		Add(1, "one").
+		Add(2, "two").
+		Prefer(1, "One").
+		Prefer(3, "three").
+		Prefer(2, "deux").
+		Synonyms(4, "four")
+	assrtEqual(t, []int{2, 3}, table.Unmatched())
+
+	_, err := table.Build()
+	assrtNotNil(t, err)
+	t.Log(err)
+
+	table.Synonyms(3, "three").Synonyms(2, "deux")
+	assrtEqual(t, 0, len(table.Unmatched()))
+	spoke, err := table.Build()
+	assrtNil(t, err)
+	result, dErr := spoke.Decode(2)
+	assrtNil(t, dErr)
+	assrtEqual(t, "deux", result)
+	hub, eErr := spoke.Encode("FOUR")
+	assrtNil(t, eErr)
+	assrtEqual(t, 4, hub)
+}
+
+func TestTableCodecErrors(t *testing.T) {
+	tt := []*TableCodec[string, int]{
+		NewTableCodec[string, int]("").Add(1, "one"),
+		NewTableCodec[string, int]("english@x").Add(1, "one"),
+		NewTableCodec[string, int]("english"),
+		NewTableCodec[string, int]("english").Add(1, "one").Add(1, "uno"),
+		NewTableCodec[string, int]("english").Add(1, "one").Add(2, "two", "One"),
+		NewTableCodec[string, int]("english").Add(1, "one", " "),
+	}
+
+	for i, table := range tt {
+		spoke, err := table.Build()
+		assrtNotNil(t, err)
+		assrtTrue(t, spoke == nil)
+		t.Logf("# %d: %v", i, err)
+	}
+}
@@ -0,0 +1,228 @@
+package timestamps
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// a numeric timestamp, counting ticks since an origin
+type epoch struct {
+	id     string
+	origin time.Time
+	// the number of ticks per second
+	perSecond *big.Rat
+	// when bool true, values may have decimal places
+	fractional bool
+	// the number of decimal places written by default (trailing zeros are dropped)
+	precision int
+	// the range of plausible values [min, max), for evaluation
+	min, max *big.Rat
+	// when bool true, values count the days of the wall clock, with the leap year bug
+	// of Lotus 1-2-3 (ie. Excel serial dates)
+	serial bool
+}
+
+// a numeric timestamp (eg. "1792314000" or "-0.5")
+var epochPattern = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+var nanosPerSecond = big.NewInt(int64(time.Second))
+
+// returns the specified rational number (eg. "1e8" or "1/86400")
+func rat(s string) (r *big.Rat) {
+	var ok bool
+	if r, ok = new(big.Rat).SetString(s); !ok {
+		panic("timestamps: invalid number " + s)
+	}
+	return
+}
+
+// the serial date of the nonexistent 1900-02-29 of Excel
+var serialLeapDay = big.NewRat(60, 1)
+
+// reads the specified numeric timestamp
+func (x *epoch) parse(v string, zone *time.Location) (r time.Time, e error) {
+	matches := epochPattern.FindStringSubmatch(v)
+	if matches == nil || (!x.fractional && len(matches[1]) > 0) {
+		e = fmt.Errorf("invalid %s timestamp [ %s ]", x.id, v)
+		return
+	}
+	ticks := rat(v)
+	if x.serial {
+		switch {
+		case ticks.Cmp(serialLeapDay) < 0:
+			ticks.Add(ticks, big.NewRat(1, 1))
+		case ticks.Cmp(new(big.Rat).Add(serialLeapDay, big.NewRat(1, 1))) < 0:
+			e = fmt.Errorf("nonexistent date 1900-02-29 [ %s ]", v)
+			return
+		}
+	}
+
+	// the nanoseconds since the origin, rounded to the nearest
+	ns := ticks.Mul(ticks, new(big.Rat).Quo(new(big.Rat).SetInt(nanosPerSecond), x.perSecond))
+	twice := new(big.Int).Lsh(ns.Num(), 1)
+	nanos := twice.Add(twice, ns.Denom()).Div(twice, new(big.Int).Lsh(ns.Denom(), 1))
+	seconds, remainder := new(big.Int).DivMod(nanos, nanosPerSecond, new(big.Int))
+	if seconds.Add(seconds, big.NewInt(x.origin.Unix())); !seconds.IsInt64() {
+		e = fmt.Errorf("%s timestamp out of range [ %s ]", x.id, v)
+		return
+	}
+	r = time.Unix(seconds.Int64(), remainder.Int64()).UTC()
+	if x.serial {
+		// the wall clock, in the specified zone
+		r = time.Date(r.Year(), r.Month(), r.Day(), r.Hour(), r.Minute(), r.Second(), r.Nanosecond(), zone)
+	}
+	return
+}
+
+// writes the specified time, as a numeric timestamp
+func (x *epoch) format(v time.Time, o decodeOptions) (r string) {
+	if x.serial {
+		t := v.In(o.zone)
+		v = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+	nanos := new(big.Int).Mul(big.NewInt(v.Unix()-x.origin.Unix()), nanosPerSecond)
+	nanos.Add(nanos, big.NewInt(int64(v.Nanosecond())))
+	ticks := new(big.Rat).SetFrac(nanos, nanosPerSecond)
+	ticks.Mul(ticks, x.perSecond)
+	if x.serial && ticks.Cmp(new(big.Rat).Add(serialLeapDay, big.NewRat(1, 1))) < 0 {
+		ticks.Sub(ticks, big.NewRat(1, 1))
+	}
+
+	precision := o.precision
+	if precision < 0 {
+		precision = x.precision
+	}
+	if r = ticks.FloatString(precision); o.precision < 0 && strings.Contains(r, ".") {
+		r = strings.TrimRight(strings.TrimRight(r, "0"), ".")
+	}
+	if strings.Trim(r, "-0.") == "" {
+		// no negative zero, after rounding
+		r = strings.TrimPrefix(r, "-")
+	}
+	return
+}
+
+func (x *epoch) codec() (r *xl8r.Spoke[string, time.Time]) {
+	r = &xl8r.Spoke[string, time.Time]{
+		Id: x.id,
+		Enc: func(v string, opts0 ...xl8r.Opts) (r time.Time, e error) {
+			var zone *time.Location
+			if zone, e = encodeZone(opts0); e != nil {
+				return
+			}
+			r, e = x.parse(strings.TrimSpace(v), zone)
+			return
+		},
+		Dec: func(v time.Time, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(opts0); e != nil {
+				return
+			}
+			r = x.format(v, o)
+			return
+		},
+		Check: func(v string) (r bool) {
+			if _, err := x.parse(v, time.UTC); err != nil {
+				return
+			}
+			ticks := rat(v)
+			r = ticks.Cmp(x.min) >= 0 && ticks.Cmp(x.max) < 0
+			return
+		},
+	}
+	return
+}
+
+// the plausible range of Unix seconds, between 1973-03-03 and 2038-01-19 (ie. 2^31)
+var (
+	unixMin = rat("1e8")
+	unixMax = rat("2147483648")
+)
+
+// returns the range of plausible values of an epoch, of the specified ticks per second
+// and offset from the Unix epoch (in seconds)
+func plausible(perSecond *big.Rat, offset int64) (min, max *big.Rat) {
+	min = new(big.Rat).Add(unixMin, big.NewRat(offset, 1))
+	max = new(big.Rat).Add(unixMax, big.NewRat(offset, 1))
+	min.Mul(min, perSecond)
+	max.Mul(max, perSecond)
+	return
+}
+
+// returns the codec for Unix seconds (eg. "1792314000.5")
+//   - evaluates values between 1e8 and 2^31 (ie. from 1973 to 2038)
+func Unix() (r *xl8r.Spoke[string, time.Time]) {
+	x := &epoch{id: "unix", origin: time.Unix(0, 0).UTC(), perSecond: rat("1"), fractional: true, precision: 9}
+	x.min, x.max = plausible(x.perSecond, 0)
+	r = x.codec()
+	return
+}
+
+// returns the codec for Unix milliseconds (eg. "1792314000500")
+//   - evaluates values between 1e11 and 2^31 seconds (ie. from 1973 to 2038)
+func UnixMilli() (r *xl8r.Spoke[string, time.Time]) {
+	x := &epoch{id: "unix-ms", origin: time.Unix(0, 0).UTC(), perSecond: rat("1e3"), fractional: true, precision: 6}
+	x.min, x.max = plausible(x.perSecond, 0)
+	r = x.codec()
+	return
+}
+
+// returns the codec for Unix nanoseconds (eg. "1792314000500000000")
+//   - evaluates values between 1e18 and 2^31 seconds (ie. from 2001 to 2038),
+//     so as not to be confused with FILETIME values
+func UnixNano() (r *xl8r.Spoke[string, time.Time]) {
+	x := &epoch{id: "unix-ns", origin: time.Unix(0, 0).UTC(), perSecond: rat("1e9")}
+	_, x.max = plausible(x.perSecond, 0)
+	x.min = rat("1e18")
+	r = x.codec()
+	return
+}
+
+// returns the codec for Excel (ie. OLE automation) serial dates (eg. "46313.375"),
+// counting days since 1899-12-30 on the wall clock of the zone set by OptZone
+//   - as in Excel, 1900 is a leap year (ie. "60" is the nonexistent 1900-02-29)
+//   - evaluates values up to 2958466 (ie. 9999-12-31)
+func Excel() (r *xl8r.Spoke[string, time.Time]) {
+	r = (&epoch{
+		id:         "excel",
+		origin:     time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC),
+		perSecond:  rat("1/86400"),
+		fractional: true,
+		precision:  9,
+		min:        rat("1"),
+		max:        rat("2958466"),
+		serial:     true,
+	}).codec()
+	return
+}
+
+// returns the codec for Windows FILETIME values (eg. "134367876000000000"),
+// counting 100-nanosecond intervals since 1601-01-01 UTC
+//   - evaluates values of the Unix seconds range (ie. from 1973 to 2038)
+func FileTime() (r *xl8r.Spoke[string, time.Time]) {
+	origin := time.Date(1601, time.January, 1, 0, 0, 0, 0, time.UTC)
+	x := &epoch{id: "filetime", origin: origin, perSecond: rat("1e7")}
+	x.min, x.max = plausible(x.perSecond, -origin.Unix())
+	r = x.codec()
+	return
+}
+
+// returns the codec for NTP timestamps (eg. "4001302800.5"), counting seconds since 1900-01-01 UTC
+//   - evaluates values between 2^31 and 2^32 (ie. from 1968 to 2036)
+func NTP() (r *xl8r.Spoke[string, time.Time]) {
+	r = (&epoch{
+		id:         "ntp",
+		origin:     time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+		perSecond:  rat("1"),
+		fractional: true,
+		precision:  9,
+		min:        rat("2147483648"),
+		max:        rat("4294967296"),
+	}).codec()
+	return
+}
@@ -0,0 +1,250 @@
+/*
+Package timestamps provides codecs for timestamps written in various formats
+(eg. "2026-10-18T09:00:00Z", "1792314000" or "46313.375"), with time.Time hub data.
+
+	convertTime, err := timestamps.New(xl8r.Config{})
+	rfc, err := convertTime.To("rfc3339", "unix-ms", "1792314000123")	// "2026-10-18T09:00:00.123Z"
+
+The codecs read and write RFC 3339, RFC 1123, Unix seconds, milliseconds and nanoseconds,
+Excel (ie. OLE automation) serial dates, Windows FILETIME and NTP timestamps,
+as well as timestamps in any Go layout (see Layout).
+
+The numeric codecs accept any value when encoding, but evaluate only values of a plausible magnitude,
+so that the epoch of a number may be told by xl8r.Origins (eg. "1792314000" is Unix seconds,
+while "1792314000123" is Unix milliseconds).
+
+Encoding and decoding may be tuned with these xl8r.Opts Enc and Dec keys:
+  - OptZone (*time.Location or string): the time zone of timestamps without offset
+    (eg. Excel serial dates), and of decoded timestamps (by default, UTC)
+
+Decoding may be tuned with these xl8r.Opts Dec keys:
+  - OptPrecision (int): the number of decimal places of seconds (or of the value, for numeric codecs),
+    by default as many as needed
+
+The codecs are also registered under the "timestamps" domain (see xl8r.NewFromDomain).
+*/
+package timestamps
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "timestamps"
+
+// the xl8r.Opts keys, read by the encoders and decoders of this package
+const (
+	OptZone      = "zone"
+	OptPrecision = "precision"
+)
+
+// returns the time zone set by OptZone, in the specified options (by default, UTC)
+func readZone(opts map[string]any) (r *time.Location, e error) {
+	r = time.UTC
+	xValue, exists := opts[OptZone]
+	if !exists {
+		return
+	}
+	switch zone := xValue.(type) {
+	case *time.Location:
+		if zone != nil {
+			r = zone
+			return
+		}
+	case string:
+		var err error
+		if r, err = time.LoadLocation(zone); err == nil && len(zone) > 0 {
+			return
+		}
+	}
+	r, e = nil, fmt.Errorf("invalid option [ %s ]: %v", OptZone, xValue)
+	return
+}
+
+// returns the time zone of encoding
+func encodeZone(opts0 []xl8r.Opts) (r *time.Location, e error) {
+	if len(opts0) == 0 {
+		r = time.UTC
+		return
+	}
+	r, e = readZone(opts0[0].Enc)
+	return
+}
+
+// the options of a single decoding
+type decodeOptions struct {
+	zone *time.Location
+	// the number of decimal places (-1, if not set)
+	precision int
+}
+
+// returns the options of decoding
+func readOptions(opts0 []xl8r.Opts) (r decodeOptions, e error) {
+	r = decodeOptions{zone: time.UTC, precision: -1}
+	if len(opts0) == 0 {
+		return
+	}
+	decoderOpts := opts0[0].Dec
+	if r.zone, e = readZone(decoderOpts); e != nil {
+		return
+	}
+	if xValue, exists := decoderOpts[OptPrecision]; exists {
+		precision, ok := xValue.(int)
+		if !ok || precision < 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptPrecision, xValue)
+			return
+		}
+		r.precision = precision
+	}
+	return
+}
+
+// returns the specified time in the zone of decoding, rounded to its precision (if set)
+func (o decodeOptions) clock(t time.Time) (r time.Time) {
+	r = t.In(o.zone)
+	if o.precision >= 0 && o.precision < 9 {
+		unit := time.Duration(1)
+		for i := o.precision; i < 9; i++ {
+			unit *= 10
+		}
+		r = r.Round(unit)
+	}
+	return
+}
+
+// returns the codec for RFC 3339 timestamps (eg. "2026-10-18T09:00:00.5-04:00")
+//   - encoding also accepts a space or lower-case "t" between date and time
+func RFC3339() (r *xl8r.Spoke[string, time.Time]) {
+	r = &xl8r.Spoke[string, time.Time]{
+		Id: "rfc3339",
+		Enc: func(v string, _ ...xl8r.Opts) (r time.Time, e error) {
+			s := strings.ToUpper(strings.TrimSpace(v))
+			if len(s) > 10 && s[10] == ' ' {
+				s = s[:10] + "T" + s[11:]
+			}
+			if r, e = time.Parse(time.RFC3339, s); e != nil {
+				e = fmt.Errorf("invalid RFC 3339 timestamp [ %s ]", v)
+			}
+			return
+		},
+		Dec: func(v time.Time, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(opts0); e != nil {
+				return
+			}
+			layout := time.RFC3339Nano
+			if o.precision >= 0 {
+				layout = "2006-01-02T15:04:05"
+				if p := min(o.precision, 9); p > 0 {
+					layout += "." + strings.Repeat("0", p)
+				}
+				layout += "Z07:00"
+			}
+			r = o.clock(v).Format(layout)
+			return
+		},
+		Check: func(v string) (r bool) {
+			_, err := time.Parse(time.RFC3339, v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns the codec for RFC 1123 timestamps (eg. "Sun, 18 Oct 2026 13:00:00 GMT")
+//   - timestamps in UTC are written as HTTP dates (ie. in "GMT"), others with a numeric offset
+func RFC1123() (r *xl8r.Spoke[string, time.Time]) {
+	parse := func(v string) (r time.Time, e error) {
+		if r, e = time.Parse(time.RFC1123Z, v); e != nil {
+			if r, e = time.Parse(time.RFC1123, v); e != nil {
+				e = fmt.Errorf("invalid RFC 1123 timestamp [ %s ]", v)
+			}
+		}
+		return
+	}
+	r = &xl8r.Spoke[string, time.Time]{
+		Id: "rfc1123",
+		Enc: func(v string, _ ...xl8r.Opts) (time.Time, error) {
+			return parse(strings.TrimSpace(v))
+		},
+		Dec: func(v time.Time, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(opts0); e != nil {
+				return
+			}
+			t := o.clock(v)
+			if t.Location() == time.UTC {
+				r = t.Format("Mon, 02 Jan 2006 15:04:05 GMT")
+				return
+			}
+			r = t.Format(time.RFC1123Z)
+			return
+		},
+		Check: func(v string) (r bool) {
+			_, err := parse(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns a codec for timestamps in the specified Go layout (eg. time.DateTime)
+//   - timestamps without offset are read in the zone set by OptZone
+func Layout(id, layout string) (r *xl8r.Spoke[string, time.Time]) {
+	r = &xl8r.Spoke[string, time.Time]{
+		Id: id,
+		Enc: func(v string, opts0 ...xl8r.Opts) (r time.Time, e error) {
+			var zone *time.Location
+			if zone, e = encodeZone(opts0); e != nil {
+				return
+			}
+			if r, e = time.ParseInLocation(layout, strings.TrimSpace(v), zone); e != nil {
+				e = fmt.Errorf("invalid %s timestamp [ %s ]", id, v)
+			}
+			return
+		},
+		Dec: func(v time.Time, opts0 ...xl8r.Opts) (r string, e error) {
+			var o decodeOptions
+			if o, e = readOptions(opts0); e != nil {
+				return
+			}
+			r = o.clock(v).Format(layout)
+			return
+		},
+		Check: func(v string) (r bool) {
+			_, err := time.Parse(layout, v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+// returns the codecs of this package: "rfc3339", "rfc1123", "unix", "unix-ms", "unix-ns",
+// "excel", "filetime", "ntp", "datetime" (ie. time.DateTime) and "date" (ie. time.DateOnly)
+func Codecs() (r []xl8r.Codec[string, time.Time]) {
+	r = []xl8r.Codec[string, time.Time]{
+		RFC3339(), RFC1123(),
+		Unix(), UnixMilli(), UnixNano(), Excel(), FileTime(), NTP(),
+		Layout("datetime", time.DateTime), Layout("date", time.DateOnly),
+	}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, time.Time], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
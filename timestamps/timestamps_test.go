@@ -0,0 +1,116 @@
+package timestamps
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestTimestamps(t *testing.T) {
+	convertTime, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	opts := func(kv ...any) xl8r.Opts {
+		dec := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			dec[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Dec: dec}
+	}
+	encOpts := func(kv ...any) xl8r.Opts {
+		o := opts(kv...)
+		return xl8r.Opts{Enc: o.Dec}
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		opts                     xl8r.Opts
+		expectedErr              bool
+	}{
+		{to: "rfc3339", from: "unix-ms", text: "1792314000123", expected: "2026-10-18T09:00:00.123Z"},
+		{to: "unix", from: "rfc3339", text: "2026-10-18T05:00:00-04:00", expected: "1792314000"},
+		{to: "unix", from: "rfc3339", text: "2026-10-18 09:00:00.5z", expected: "1792314000.5"},
+		{to: "unix-ns", from: "unix", text: "1792314000.123456789", expected: "1792314000123456789"},
+		{to: "unix-ms", from: "unix-ns", text: "1792314000123456789", expected: "1792314000123.456789"},
+		{to: "unix-ms", from: "unix-ns", text: "1792314000123456789", opts: opts(OptPrecision, 0), expected: "1792314000123"},
+		{to: "rfc3339", from: "unix", text: "1792314000.123456789", opts: opts(OptPrecision, 3), expected: "2026-10-18T09:00:00.123Z"},
+		{to: "rfc3339", from: "unix", text: "1792314000", opts: opts(OptPrecision, 2), expected: "2026-10-18T09:00:00.00Z"},
+		{to: "rfc3339", from: "unix", text: "1792314000", opts: opts(OptZone, "America/New_York"), expected: "2026-10-18T05:00:00-04:00"},
+		{to: "rfc3339", from: "unix", text: "1792314000", opts: opts(OptZone, newYork), expected: "2026-10-18T05:00:00-04:00"},
+		{to: "rfc1123", from: "unix", text: "1792314000", expected: "Sun, 18 Oct 2026 09:00:00 GMT"},
+		{to: "rfc1123", from: "unix", text: "1792314000", opts: opts(OptZone, "America/New_York"), expected: "Sun, 18 Oct 2026 05:00:00 -0400"},
+		{to: "unix", from: "rfc1123", text: "Sun, 18 Oct 2026 09:00:00 GMT", expected: "1792314000"},
+		{to: "unix", from: "rfc1123", text: "Sun, 18 Oct 2026 05:00:00 -0400", expected: "1792314000"},
+		{to: "excel", from: "unix", text: "1792314000", expected: "46313.375"},
+		{to: "excel", from: "unix", text: "1792314000", opts: opts(OptZone, "America/New_York"), expected: "46313.208333333"},
+		{to: "unix", from: "excel", text: "46313.375", expected: "1792314000"},
+		{to: "unix", from: "excel", text: "46313.375", opts: encOpts(OptZone, "America/New_York"), expected: "1792328400"},
+		{to: "date", from: "excel", text: "1", expected: "1900-01-01"},
+		{to: "date", from: "excel", text: "59", expected: "1900-02-28"},
+		{to: "date", from: "excel", text: "61", expected: "1900-03-01"},
+		{to: "excel", from: "date", text: "1900-02-28", expected: "59"},
+		{to: "excel", from: "date", text: "1900-03-01", expected: "61"},
+		{to: "date", from: "excel", text: "60", expectedErr: true},
+		{to: "filetime", from: "unix", text: "1792314000", expected: "134367876000000000"},
+		{to: "unix", from: "filetime", text: "116444736000000000", expected: "0"},
+		{to: "ntp", from: "unix", text: "1792314000.25", expected: "4001302800.25"},
+		{to: "rfc3339", from: "ntp", text: "0", expected: "1900-01-01T00:00:00Z"},
+		{to: "datetime", from: "unix", text: "-1", expected: "1969-12-31 23:59:59"},
+		{to: "unix", from: "datetime", text: "2026-10-18 05:00:00", opts: encOpts(OptZone, "America/New_York"), expected: "1792314000"},
+		{to: "unix", from: "unix-ns", text: "1.5", expectedErr: true},
+		{to: "unix", from: "unix", text: "1e9", expectedErr: true},
+		{to: "unix", from: "rfc3339", text: "2026-10-18", expectedErr: true},
+		{to: "unix", from: "unix", text: "1", opts: opts(OptZone, "Nowhere/Special"), expectedErr: true},
+		{to: "unix", from: "unix", text: "1", opts: opts(OptPrecision, -1), expectedErr: true},
+		{to: "unix", from: "filetime", text: "999999999999999999999999999999", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertTime.To(tx.to, tx.from, tx.text, tx.opts)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s",%v%v) ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, tx.opts.Enc, tx.opts.Dec, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	tt := []struct {
+		text     string
+		expected []string
+	}{
+		{"1792314000", []string{"unix"}},
+		{"1792314000.5", []string{"unix"}},
+		{"1792314000123", []string{"unix-ms"}},
+		{"1792314000123456789", []string{"unix-ns"}},
+		{"134367876000000000", []string{"filetime"}},
+		{"4001302800", []string{"ntp"}},
+		{"46313.375", []string{"excel"}},
+		{"2026-10-18T09:00:00Z", []string{"rfc3339"}},
+		{"Sun, 18 Oct 2026 09:00:00 GMT", []string{"rfc1123"}},
+		{"2026-10-18 09:00:00", []string{"datetime"}},
+		{"2026-10-18", []string{"date"}},
+		{" 1792314000", nil},
+	}
+	for i, tx := range tt {
+		var result []string
+		for _, c := range Codecs() {
+			if c.Evaluate(tx.text) {
+				result = append(result, c.Name())
+			}
+		}
+		if len(result) != len(tx.expected) || (len(result) > 0 && result[0] != tx.expected[0]) {
+			t.Errorf(`# %d: Evaluate("%s") ==>> %v, expected %v`, i, tx.text, result, tx.expected)
+		}
+	}
+
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Package timezones provides codecs for wall clock times in IANA time zones
+(eg. "2026-10-18 09:00:00 America/New_York"), with time.Time hub data (ie. an absolute instant).
+Each codec is named after its zone, so that translating between codecs converts between zones.
+
+	convertZone, err := timezones.New(xl8r.Config{})
+	tokyo, err := convertZone.To("Asia/Tokyo", "America/New_York", "2026-10-18 09:00 America/New_York")	// "2026-10-18 22:00:00 Asia/Tokyo"
+
+The zone database is embedded (see time/tzdata), so that the codecs work offline,
+and on systems without one. Zone names contain slashes, so that the codecs of a region
+may be listed, or scoped to (eg. Interpreter.List("Europe") or Interpreter.Namespace("America")).
+
+Content is written with its zone name, which is optional when encoding, and required when evaluating.
+
+Encoding and decoding may be tuned with these xl8r.Opts Enc and Dec keys:
+  - OptLayout (string): the Go layout of the wall clock time (by default, time.DateTime,
+    and also "2006-01-02 15:04" or "2006-01-02T15:04:05" when encoding)
+    (content read with a zone or offset, eg. by time.RFC3339, is encoded as the instant it names)
+
+Encoding may be tuned with these xl8r.Opts Enc keys:
+  - OptGap (GapPolicy): how wall clock times skipped by a transition are resolved
+    (by default, GapShiftForward)
+  - OptOverlap (OverlapPolicy): how wall clock times repeated by a transition are resolved
+    (by default, OverlapEarlier)
+
+The codecs of the zones listed in Zones are also registered under the "timezones" domain
+(see xl8r.NewFromDomain), and constructed on first use.
+*/
+package timezones
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "timezones"
+
+// the xl8r.Opts keys, read by the encoders and decoders of this package
+const (
+	OptLayout  = "layout"
+	OptGap     = "gap"
+	OptOverlap = "overlap"
+)
+
+// how a wall clock time is resolved, when skipped by a transition (eg. 02:30 when clocks spring forward)
+type GapPolicy string
+
+const (
+	// resolves to the instant at the offset in effect before the transition
+	// (ie. the wall clock time is moved forward by the length of the gap, eg. to 03:30)
+	GapShiftForward GapPolicy = "shift-forward"
+	// resolves to the instant of the transition (eg. 03:00)
+	GapNextValid GapPolicy = "next-valid"
+	// returns an error wrapping ErrNonexistent
+	GapReject GapPolicy = "reject"
+)
+
+// how a wall clock time is resolved, when repeated by a transition (eg. 01:30 when clocks fall back)
+type OverlapPolicy string
+
+const (
+	// resolves to the earlier instant (ie. at the offset in effect before the transition)
+	OverlapEarlier OverlapPolicy = "earlier"
+	// resolves to the later instant (ie. at the offset in effect after the transition)
+	OverlapLater OverlapPolicy = "later"
+	// returns an error wrapping ErrAmbiguous
+	OverlapReject OverlapPolicy = "reject"
+)
+
+var (
+	// returned when a wall clock time is skipped by a transition, and GapReject is set
+	ErrNonexistent = errors.New("nonexistent wall clock time")
+	// returned when a wall clock time is repeated by a transition, and OverlapReject is set
+	ErrAmbiguous = errors.New("ambiguous wall clock time")
+)
+
+// the zones of the codecs registered under the domain, and returned by Codecs()
+//   - codecs of other zones may be built with Zone
+var Zones = []string{
+	"UTC",
+	"Africa/Cairo", "Africa/Johannesburg", "Africa/Lagos", "Africa/Nairobi",
+	"America/Anchorage", "America/Bogota", "America/Chicago", "America/Denver", "America/Halifax",
+	"America/Los_Angeles", "America/Mexico_City", "America/New_York", "America/Phoenix",
+	"America/Santiago", "America/Sao_Paulo", "America/St_Johns", "America/Toronto", "America/Vancouver",
+	"Asia/Dhaka", "Asia/Dubai", "Asia/Hong_Kong", "Asia/Jakarta", "Asia/Jerusalem", "Asia/Karachi",
+	"Asia/Kathmandu", "Asia/Kolkata", "Asia/Seoul", "Asia/Shanghai", "Asia/Singapore", "Asia/Tehran",
+	"Asia/Tokyo",
+	"Atlantic/Reykjavik",
+	"Australia/Adelaide", "Australia/Brisbane", "Australia/Perth", "Australia/Sydney",
+	"Europe/Amsterdam", "Europe/Athens", "Europe/Berlin", "Europe/Istanbul", "Europe/Lisbon",
+	"Europe/London", "Europe/Madrid", "Europe/Moscow", "Europe/Paris", "Europe/Rome", "Europe/Zurich",
+	"Pacific/Auckland", "Pacific/Chatham", "Pacific/Honolulu",
+}
+
+// the layouts accepted when encoding, when no layout is specified
+var defaultLayouts = []string{time.DateTime, "2006-01-02 15:04", "2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// the options of a single encoding or decoding
+type options struct {
+	layouts []string
+	gap     GapPolicy
+	overlap OverlapPolicy
+}
+
+// returns the options set in the specified Enc or Dec map
+func readOptions(opts map[string]any) (r options, e error) {
+	r = options{layouts: defaultLayouts, gap: GapShiftForward, overlap: OverlapEarlier}
+	if xValue, exists := opts[OptLayout]; exists {
+		layout, ok := xValue.(string)
+		if !ok || len(layout) == 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptLayout, xValue)
+			return
+		}
+		r.layouts = []string{layout}
+	}
+	if xValue, exists := opts[OptGap]; exists {
+		gap, ok := xValue.(GapPolicy)
+		if !ok || (gap != GapShiftForward && gap != GapNextValid && gap != GapReject) {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptGap, xValue)
+			return
+		}
+		r.gap = gap
+	}
+	if xValue, exists := opts[OptOverlap]; exists {
+		overlap, ok := xValue.(OverlapPolicy)
+		if !ok || (overlap != OverlapEarlier && overlap != OverlapLater && overlap != OverlapReject) {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptOverlap, xValue)
+			return
+		}
+		r.overlap = overlap
+	}
+	return
+}
+
+// returns the instant of the specified wall clock time (read as UTC), in the specified zone
+func resolve(wall time.Time, loc *time.Location, o options) (r time.Time, e error) {
+	// the instants of the wall clock time, at each offset in effect around it
+	var valid []time.Time
+	var shifted time.Time
+	seen := make(map[int]bool)
+	for _, probe := range []time.Time{wall.Add(-24 * time.Hour), wall, wall.Add(24 * time.Hour)} {
+		_, offset := probe.In(loc).Zone()
+		if seen[offset] {
+			continue
+		}
+		seen[offset] = true
+		t := wall.Add(-time.Duration(offset) * time.Second)
+		if _, actual := t.In(loc).Zone(); actual == offset {
+			valid = append(valid, t)
+		} else if t.After(shifted) {
+			shifted = t
+		}
+	}
+
+	switch {
+	case len(valid) == 1:
+		r = valid[0]
+	case len(valid) > 1:
+		sort.Slice(valid, func(i, j int) bool { return valid[i].Before(valid[j]) })
+		earlier, later := valid[0], valid[len(valid)-1]
+		switch o.overlap {
+		case OverlapLater:
+			r = later
+		case OverlapReject:
+			e = fmt.Errorf("%w [ %s %s ]", ErrAmbiguous, wall.Format(time.DateTime), loc)
+		default:
+			r = earlier
+		}
+	default:
+		switch o.gap {
+		case GapNextValid:
+			r, _ = shifted.In(loc).ZoneBounds()
+		case GapReject:
+			e = fmt.Errorf("%w [ %s %s ]", ErrNonexistent, wall.Format(time.DateTime), loc)
+		default:
+			r = shifted
+		}
+	}
+	return
+}
+
+// returns bool true, if the specified time was parsed with a zone or an offset (eg. "+09:00"),
+// rather than as a wall clock time
+//   - a wall clock time is read as UTC by time.Parse, and as another instant in another location
+func zoned(layout, value string, parsed time.Time) (r bool) {
+	t, err := time.ParseInLocation(layout, value, time.FixedZone("", 3600))
+	r = err == nil && t.Equal(parsed)
+	return
+}
+
+// returns the codec for wall clock times in the specified IANA zone (eg. "America/New_York")
+//   - returns an error, if the zone is unknown
+func Zone(name string) (r *xl8r.Spoke[string, time.Time], e error) {
+	var loc *time.Location
+	if loc, e = time.LoadLocation(name); e != nil || len(name) == 0 || name == "Local" {
+		e = fmt.Errorf("timezones codec [ '%s' ]: unknown zone", name)
+		return
+	}
+	suffix := " " + name
+	r = &xl8r.Spoke[string, time.Time]{
+		Id: name,
+		Enc: func(v string, opts0 ...xl8r.Opts) (r time.Time, e error) {
+			var encoderOpts map[string]any
+			if len(opts0) > 0 {
+				encoderOpts = opts0[0].Enc
+			}
+			var o options
+			if o, e = readOptions(encoderOpts); e != nil {
+				return
+			}
+			s := strings.TrimSuffix(strings.TrimSpace(v), suffix)
+			for _, layout := range o.layouts {
+				if wall, err := time.Parse(layout, s); err == nil {
+					if zoned(layout, s, wall) {
+						r = wall.UTC()
+					} else {
+						r, e = resolve(wall, loc, o)
+					}
+					return
+				}
+			}
+			e = fmt.Errorf("invalid wall clock time of %s [ %s ]", name, v)
+			return
+		},
+		Dec: func(v time.Time, opts0 ...xl8r.Opts) (r string, e error) {
+			var decoderOpts map[string]any
+			if len(opts0) > 0 {
+				decoderOpts = opts0[0].Dec
+			}
+			var o options
+			if o, e = readOptions(decoderOpts); e != nil {
+				return
+			}
+			r = v.In(loc).Format(o.layouts[0]) + suffix
+			return
+		},
+		Check: check(name),
+	}
+	return
+}
+
+// returns a strict evaluator, of the default layout and zone name (eg. "2026-10-18 09:00:00 Asia/Tokyo")
+func check(name string) xl8r.Evaluator[string] {
+	suffix := " " + name
+	return func(v string) (r bool) {
+		if wall, found := strings.CutSuffix(v, suffix); found {
+			_, err := time.Parse(time.DateTime, wall)
+			r = err == nil
+		}
+		return
+	}
+}
+
+// returns a codec for the specified zone, that is constructed on first use
+func lazy(name string) (r *xl8r.Lazy[string, time.Time]) {
+	r = &xl8r.Lazy[string, time.Time]{
+		Id: name,
+		Factory: func() (xl8r.Codec[string, time.Time], error) {
+			return Zone(name)
+		},
+		Check: check(name),
+	}
+	return
+}
+
+// returns the codecs of the zones listed in Zones, constructed on first use
+func Codecs() (r []xl8r.Codec[string, time.Time]) {
+	for _, name := range Zones {
+		r = append(r, lazy(name))
+	}
+	return
+}
+
+// creates a new Interpreter instance, with the codecs of the specified zones
+// (by default, those listed in Zones)
+func New(cfg xl8r.Config, zones ...string) (r xl8r.Interpreter[string, time.Time], e error) {
+	codecs := Codecs()
+	if len(zones) > 0 {
+		codecs = nil
+		for _, name := range zones {
+			var c *xl8r.Spoke[string, time.Time]
+			if c, e = Zone(name); e != nil {
+				return
+			}
+			codecs = append(codecs, c)
+		}
+	}
+	r, e = xl8r.NewWithConfig(cfg, codecs...)
+	return
+}
+
+func init() {
+	for _, name := range Zones {
+		name := name
+		xl8r.RegisterFactory(Domain, name, func() (xl8r.Codec[string, time.Time], error) {
+			return Zone(name)
+		})
+	}
+}
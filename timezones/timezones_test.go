@@ -0,0 +1,121 @@
+package timezones
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestTimezones(t *testing.T) {
+	convertZone, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encOpts := func(kv ...any) xl8r.Opts {
+		enc := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			enc[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Enc: enc}
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		opts                     xl8r.Opts
+		expectedErr              error
+	}{
+		{to: "Asia/Tokyo", from: "America/New_York", text: "2026-10-18 09:00 America/New_York", expected: "2026-10-18 22:00:00 Asia/Tokyo"},
+		{to: "Asia/Tokyo", from: "America/New_York", text: "2026-10-18T09:00", expected: "2026-10-18 22:00:00 Asia/Tokyo"},
+		{to: "Asia/Kolkata", from: "UTC", text: "2026-01-01 00:00:00 UTC", expected: "2026-01-01 05:30:00 Asia/Kolkata"},
+		{to: "Asia/Kathmandu", from: "UTC", text: "2026-01-01 00:00:00", expected: "2026-01-01 05:45:00 Asia/Kathmandu"},
+		{to: "Europe/London", from: "Australia/Sydney", text: "2026-10-18 09:00:00", expected: "2026-10-17 23:00:00 Europe/London"},
+		{to: "UTC", from: "America/New_York", text: "2026-10-18 09:00", opts: xl8r.Opts{Dec: map[string]any{OptLayout: time.RFC3339}}, expected: "2026-10-18T13:00:00Z UTC"},
+		{to: "UTC", from: "America/New_York", text: "10/18/2026 9:00AM", opts: encOpts(OptLayout, "01/02/2006 3:04PM"), expected: "2026-10-18 13:00:00 UTC"},
+
+		// content with an offset names an instant, whatever the zone of the codec
+		{to: "UTC", from: "America/New_York", text: "2026-10-18T09:00:00+09:00", opts: encOpts(OptLayout, time.RFC3339), expected: "2026-10-18 00:00:00 UTC"},
+		{to: "UTC", from: "America/New_York", text: "2026-10-18T09:00:00Z", opts: encOpts(OptLayout, time.RFC3339), expected: "2026-10-18 09:00:00 UTC"},
+		{to: "Asia/Tokyo", from: "America/New_York", text: "2026-10-18T09:00:00-04:00", opts: encOpts(OptLayout, time.RFC3339), expected: "2026-10-18 22:00:00 Asia/Tokyo"},
+
+		// a gap: clocks spring forward from 02:00 to 03:00
+		{to: "UTC", from: "America/New_York", text: "2026-03-08 02:30", expected: "2026-03-08 07:30:00 UTC"},
+		{to: "America/New_York", from: "America/New_York", text: "2026-03-08 02:30", expected: "2026-03-08 03:30:00 America/New_York"},
+		{to: "UTC", from: "America/New_York", text: "2026-03-08 02:30", opts: encOpts(OptGap, GapNextValid), expected: "2026-03-08 07:00:00 UTC"},
+		{to: "UTC", from: "America/New_York", text: "2026-03-08 02:30", opts: encOpts(OptGap, GapReject), expectedErr: ErrNonexistent},
+		{to: "UTC", from: "America/New_York", text: "2026-03-08 03:00", opts: encOpts(OptGap, GapReject), expected: "2026-03-08 07:00:00 UTC"},
+
+		// an overlap: clocks fall back from 02:00 to 01:00
+		{to: "UTC", from: "America/New_York", text: "2026-11-01 01:30", expected: "2026-11-01 05:30:00 UTC"},
+		{to: "UTC", from: "America/New_York", text: "2026-11-01 01:30", opts: encOpts(OptOverlap, OverlapLater), expected: "2026-11-01 06:30:00 UTC"},
+		{to: "UTC", from: "America/New_York", text: "2026-11-01 01:30", opts: encOpts(OptOverlap, OverlapReject), expectedErr: ErrAmbiguous},
+
+		// a gap and an overlap, in the southern hemisphere
+		{to: "UTC", from: "Pacific/Auckland", text: "2026-09-27 02:30", expected: "2026-09-26 14:30:00 UTC"},
+		{to: "UTC", from: "Pacific/Auckland", text: "2026-04-05 02:30", opts: encOpts(OptOverlap, OverlapLater), expected: "2026-04-04 14:30:00 UTC"},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertZone.To(tx.to, tx.from, tx.text, tx.opts)
+		if result != tx.expected || (tErr == nil) != (tx.expectedErr == nil) || (tx.expectedErr != nil && !errors.Is(tErr, tx.expectedErr)) {
+			t.Errorf(`# %d: To("%s","%s","%s") ==>> "%s" %v, expected "%s" %v`, i, tx.to, tx.from, tx.text, result, tErr, tx.expected, tx.expectedErr)
+		}
+	}
+
+	for i, tx := range []struct {
+		to, from, text string
+		opts           xl8r.Opts
+	}{
+		{to: "UTC", from: "America/New_York", text: "2026-10-18 09:00 Asia/Tokyo"},
+		{to: "UTC", from: "America/New_York", text: "09:00"},
+		{to: "UTC", from: "America/New_York", text: "2026-10-18 09:00", opts: encOpts(OptGap, "later")},
+		{to: "UTC", from: "America/New_York", text: "2026-10-18 09:00", opts: encOpts(OptOverlap, GapReject)},
+		{to: "UTC", from: "America/New_York", text: "2026-10-18 09:00", opts: encOpts(OptLayout, "")},
+	} {
+		if result, tErr := convertZone.To(tx.to, tx.from, tx.text, tx.opts); tErr == nil {
+			t.Errorf(`# %d: To("%s","%s","%s") ==>> "%s", expected an error`, i, tx.to, tx.from, tx.text, result)
+		}
+	}
+}
+
+func TestZones(t *testing.T) {
+	convertZone, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if origins := convertZone.Origins("2026-10-18 22:00:00 Asia/Tokyo"); len(origins) != 1 || origins[0] != "Asia/Tokyo" {
+		t.Errorf(`Origins(..) ==>> %v, expected [Asia/Tokyo]`, origins)
+	}
+	if origins := convertZone.Origins("2026-10-18 22:00 Asia/Tokyo"); len(origins) != 0 {
+		t.Errorf(`Origins(..) ==>> %v, expected none`, origins)
+	}
+	if names := convertZone.List("Australia"); len(names) != 4 {
+		t.Errorf(`List("Australia") ==>> %v`, names)
+	}
+
+	custom, err := New(xl8r.Config{}, "Europe/Kyiv", "America/Argentina/Buenos_Aires")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, tErr := custom.To("America/Argentina/Buenos_Aires", "Europe/Kyiv", "2026-10-18 12:00"); tErr != nil || result != "2026-10-18 06:00:00 America/Argentina/Buenos_Aires" {
+		t.Errorf(`To(..) ==>> "%s" %v`, result, tErr)
+	}
+	for _, name := range []string{"Nowhere/Special", "Local", ""} {
+		if _, err := Zone(name); err == nil {
+			t.Errorf(`Zone("%s"): expected an error`, name)
+		}
+	}
+
+	fromDomain, err := xl8r.NewFromDomain[string, time.Time](xl8r.Config{}, Domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, tErr := fromDomain.To("Europe/Paris", "UTC", "2026-07-14 10:00:00 UTC"); tErr != nil || result != "2026-07-14 12:00:00 Europe/Paris" {
+		t.Errorf(`To(..) ==>> "%s" %v`, result, tErr)
+	}
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+}
@@ -0,0 +1,307 @@
+package xl8r
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+var _ Codec[string, TokenSeq[int]] = (*TokenCodec[string, int])(nil) //contract
+var _ Initializer = (*TokenCodec[string, int])(nil)                  //contract
+var _ io.Closer = (*TokenCodec[string, int])(nil)                    //contract
+var _ HealthChecker = (*TokenCodec[string, int])(nil)                //contract
+
+// a piece of content, as produced by a Tokenizer
+type Token struct {
+	// the text of the token
+	Text string
+	// the byte offset of the token, within the content
+	Pos int
+	// bool true, if the token is translated (ie. a word),
+	// bool false, if it is kept as it is (eg. whitespace and punctuation)
+	Word bool
+}
+
+// a function that splits content into tokens
+//   - the texts of the tokens, when concatenated, should reproduce the content
+type Tokenizer func(content string) (r []Token)
+
+// a function that joins (decoded) tokens into content
+type Joiner func(tokens []Token) (r string)
+
+// a single item of the hub data of a TokenCodec
+type TokenItem[H any] struct {
+	// the hub data value of a word
+	Hub H
+	// the text of a token that is kept as it is (eg. whitespace and punctuation)
+	Text string
+	// bool true, if the item holds the hub data value of a word
+	Word bool
+}
+
+// the hub data of a TokenCodec
+//   - words are held as hub data values, everything else is held as text
+type TokenSeq[H any] []TokenItem[H]
+
+// returns the hub data values of the words
+func (s TokenSeq[H]) Words() (r []H) {
+	for _, item := range s {
+		if item.Word {
+			r = append(r, item.Hub)
+		}
+	}
+	return
+}
+
+// creates the hub data of a TokenCodec, from the specified word values, separated by the specified text
+func WordSeq[H any](sep string, words ...H) (r TokenSeq[H]) {
+	for i, word := range words {
+		if i > 0 && len(sep) > 0 {
+			r = append(r, TokenItem[H]{Text: sep})
+		}
+		r = append(r, TokenItem[H]{Hub: word, Word: true})
+	}
+	return
+}
+
+// a named codec, that translates content word by word
+//   - content is split into tokens by the Tokenizer, and each word is encoded by the Word codec
+//   - whitespace, punctuation and other non-word tokens are kept as they are
+//   - hub data is decoded word by word, and the tokens are joined by the Joiner
+//   - Init, Close and Health are forwarded to the Word codec (if it has them)
+type TokenCodec[P ~string, H any] struct {
+	// name of the codec
+	Id string
+	// the codec that translates a single word (eg. a TableCodec)
+	Word Codec[string, H]
+	// optional function that splits content into tokens
+	//   - when nil, WordTokenizer is used
+	Tokenize Tokenizer
+	// optional function that joins (decoded) tokens into content
+	//   - when nil, the texts of the tokens are concatenated
+	Join Joiner
+}
+
+// the error returned for a token that could not be translated
+type TokenError struct {
+	// name of the codec
+	Codec string
+	// the text of the token (empty, if it could not be decoded)
+	Token string
+	// the index of the token (or hub data item)
+	Index int
+	// the byte offset of the token within the content
+	//   - when decoding, the offset within the content produced so far
+	Pos int
+	Err error
+}
+
+func (e *TokenError) Error() string {
+	return fmt.Sprintf("token [ '%s' #%d @%d ] of '%s': %v", e.Token, e.Index, e.Pos, e.Codec, e.Err)
+}
+
+func (e *TokenError) Unwrap() error {
+	return e.Err
+}
+
+// name of the codec
+func (x *TokenCodec[P, H]) Name() string {
+	return x.Id
+}
+
+func (x *TokenCodec[P, H]) tokenize(v P) []Token {
+	if tokenize := x.Tokenize; tokenize != nil {
+		return tokenize(string(v))
+	}
+	return WordTokenizer(string(v))
+}
+
+// converts content into hub data, word by word
+//   - all words that cannot be encoded are reported, as a joined *TokenError for each
+func (x *TokenCodec[P, H]) Encode(v P, opts0 ...Opts) (r TokenSeq[H], e error) {
+	if x.Word == nil {
+		e = fmt.Errorf("nil word codec")
+		return
+	}
+	var errs []error
+	for i, token := range x.tokenize(v) {
+		if !token.Word {
+			r = append(r, TokenItem[H]{Text: token.Text})
+			continue
+		}
+		hub, err := x.Word.Encode(token.Text, opts0...)
+		if err != nil {
+			errs = append(errs, &TokenError{Codec: x.Id, Token: token.Text, Index: i, Pos: token.Pos, Err: err})
+			continue
+		}
+		r = append(r, TokenItem[H]{Hub: hub, Word: true})
+	}
+	if e = errors.Join(errs...); e != nil {
+		r = nil
+	}
+	return
+}
+
+// converts hub data into content, word by word
+//   - all words that cannot be decoded are reported, as a joined *TokenError for each
+func (x *TokenCodec[P, H]) Decode(v TokenSeq[H], opts0 ...Opts) (r P, e error) {
+	if x.Word == nil {
+		e = fmt.Errorf("nil word codec")
+		return
+	}
+	var errs []error
+	tokens := make([]Token, 0, len(v))
+	pos := 0
+	for i, item := range v {
+		token := Token{Text: item.Text, Pos: pos}
+		if item.Word {
+			text, err := x.Word.Decode(item.Hub, opts0...)
+			if err != nil {
+				errs = append(errs, &TokenError{Codec: x.Id, Index: i, Pos: pos, Err: err})
+				continue
+			}
+			token.Text, token.Word = text, true
+		}
+		tokens = append(tokens, token)
+		pos += len(token.Text)
+	}
+	if e = errors.Join(errs...); e != nil {
+		return
+	}
+	if join := x.Join; join != nil {
+		r = P(join(tokens))
+		return
+	}
+	buf := &strings.Builder{}
+	for _, token := range tokens {
+		buf.WriteString(token.Text)
+	}
+	r = P(buf.String())
+	return
+}
+
+// returns bool true, if the content has at least one word, and all of its words are processable by the Word codec
+func (x *TokenCodec[P, H]) Evaluate(v P) (r bool) {
+	if x.Word == nil {
+		return
+	}
+	for _, token := range x.tokenize(v) {
+		if !token.Word {
+			continue
+		}
+		if !x.Word.Evaluate(token.Text) {
+			return false
+		}
+		r = true
+	}
+	return
+}
+
+func (x *TokenCodec[P, H]) Init() (e error) {
+	if initializer, ok := x.Word.(Initializer); ok {
+		e = initializer.Init()
+	}
+	return
+}
+
+func (x *TokenCodec[P, H]) Close() (e error) {
+	if closer, ok := x.Word.(io.Closer); ok {
+		e = closer.Close()
+	}
+	return
+}
+
+func (x *TokenCodec[P, H]) Health() (e error) {
+	if checker, ok := x.Word.(HealthChecker); ok {
+		e = checker.Health()
+	}
+	return
+}
+
+// splits content into words (letters, digits, marks and word-internal apostrophes),
+// and everything else (whitespace and punctuation, one token per rune)
+//   - leading and trailing apostrophes are punctuation (eg. of single-quoted words)
+//   - eg. `it's 'tlhIngan'!` ==>> [it's] [ ] ['] [tlhIngan] ['] [!]
+func WordTokenizer(content string) (r []Token) {
+	for _, token := range splitTokens(content, isWordRune, false) {
+		if token.Word {
+			r = append(r, splitApostrophes(token)...)
+		} else {
+			r = append(r, token)
+		}
+	}
+	return
+}
+
+// splits content into runs of whitespace, and words (everything else)
+//   - eg. `one, two` ==>> [one,] [ ] [two]
+func SpaceTokenizer(content string) (r []Token) {
+	return splitTokens(content, func(c rune) bool { return !unicode.IsSpace(c) }, true)
+}
+
+// the apostrophes, that may occur within words
+const apostrophes = "'’"
+
+func isWordRune(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || unicode.IsMark(c) || strings.ContainsRune(apostrophes, c)
+}
+
+// splits the leading and trailing apostrophes off the specified word, as punctuation (one token per rune)
+func splitApostrophes(word Token) (r []Token) {
+	start := len(word.Text) - len(strings.TrimLeft(word.Text, apostrophes))
+	end := len(strings.TrimRight(word.Text, apostrophes))
+	if end < start {
+		// apostrophes only
+		end = start
+	}
+	for i, c := range word.Text[:start] {
+		r = append(r, Token{Text: string(c), Pos: word.Pos + i})
+	}
+	if end > start {
+		r = append(r, Token{Text: word.Text[start:end], Pos: word.Pos + start, Word: true})
+	}
+	for i, c := range word.Text[end:] {
+		r = append(r, Token{Text: string(c), Pos: word.Pos + end + i})
+	}
+	return
+}
+
+// splits content into runs of word runes, and other runes
+//   - other runes are grouped into runs, or else kept as one token per rune
+func splitTokens(content string, inWord func(rune) bool, groupOthers bool) (r []Token) {
+	start := 0
+	var current *Token
+	for i, c := range content {
+		word := inWord(c)
+		if current != nil && current.Word == word && (word || groupOthers) {
+			continue
+		}
+		if current != nil {
+			current.Text = content[start:i]
+			r = append(r, *current)
+		}
+		start = i
+		current = &Token{Pos: i, Word: word}
+	}
+	if current != nil {
+		current.Text = content[start:]
+		r = append(r, *current)
+	}
+	return
+}
+
+// returns a Joiner, that joins the words with the specified separator, and drops all other tokens
+//   - eg. JoinWords("") for languages written without spaces
+func JoinWords(sep string) Joiner {
+	return func(tokens []Token) string {
+		words := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			if token.Word {
+				words = append(words, token.Text)
+			}
+		}
+		return strings.Join(words, sep)
+	}
+}
@@ -0,0 +1,123 @@
+package xl8r
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testTokenCodec(t *testing.T, id string, words ...string) *TokenCodec[myLanguageContentType, int] {
+	t.Helper()
+	table := NewTableCodec[string, int](id)
+	for i, word := range words {
+		table.Add(i+1, word)
+	}
+	table.Normalize = func(s string) string {
+		return testFixQuoteChars(strings.ToLower(strings.TrimSpace(s)), "'", "’", "‘")
+	}
+	spoke, err := table.Build()
+	assrtNil(t, err)
+	return &TokenCodec[myLanguageContentType, int]{Id: id, Word: spoke}
+}
+
+func TestTokenCodec(t *testing.T) {
+	english := testTokenCodec(t, "english", "one", "two", "three")
+	klingon := testTokenCodec(t, "klingon", "wa'", "cha'", "wej")
+	// Klingon words may end with an apostrophe (eg. "wa'")
+	klingon.Tokenize = func(content string) []Token {
+		return splitTokens(content, isWordRune, false)
+	}
+	japanese := testTokenCodec(t, "japanese", "一", "二", "三")
+	japanese.Tokenize = func(content string) (r []Token) {
+		for i, c := range content {
+			r = append(r, Token{Text: string(c), Pos: i, Word: !strings.ContainsRune(" 、。", c)})
+		}
+		return
+	}
+	japanese.Join = JoinWords("")
+
+	translate, err := New[myLanguageContentType, TokenSeq[int]](english, klingon, japanese)
+	assrtNil(t, err)
+
+	tt := []struct {
+		to, from, text, expected string
+		expectedErr              bool
+	}{
+		{to: "klingon", from: "english", text: `One, two -- "three"!`, expected: `wa', cha' -- "wej"!`},
+		{to: "english", from: "klingon", text: "wa’\tcha’ wej.", expected: "one\ttwo three."},
+		{to: "japanese", from: "english", text: "one two, three", expected: "一二三"},
+		{to: "english", from: "japanese", text: "一二、三", expected: "onetwo、three"},
+		{to: "klingon", from: "english", text: "one four", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := translate.To(tx.to, tx.from, myLanguageContentType(tx.text))
+		assrtEqual(t, myLanguageContentType(tx.expected), result)
+		assrtEqual(t, tx.expectedErr, tErr != nil)
+		t.Logf(`# %d: To("%s","%s","%s") ==>> "%s" %v`, i, tx.to, tx.from, tx.text, result, tErr)
+	}
+
+	assrtEqual(t, []string{"klingon"}, translate.Origins("wa' wej?"))
+	assrtEqual(t, 0, len(translate.Origins(" ... ")))
+}
+
+func TestTokenCodecErrors(t *testing.T) {
+	english := testTokenCodec(t, "english", "one", "two", "three")
+
+	_, eErr := english.Encode("one, four five")
+	var tokenErrs []*TokenError
+	for _, err := range eErr.(interface{ Unwrap() []error }).Unwrap() {
+		var tokenErr *TokenError
+		assrtTrue(t, errors.As(err, &tokenErr))
+		tokenErrs = append(tokenErrs, tokenErr)
+	}
+	assrtEqual(t, 2, len(tokenErrs))
+	assrtEqual(t, "four", tokenErrs[0].Token)
+	assrtEqual(t, 5, tokenErrs[0].Pos)
+	assrtEqual(t, 3, tokenErrs[0].Index)
+	assrtEqual(t, "five", tokenErrs[1].Token)
+	assrtEqual(t, 10, tokenErrs[1].Pos)
+	t.Log(eErr)
+
+	_, dErr := english.Decode(WordSeq(" ", 1, 2, 7))
+	var tokenErr *TokenError
+	assrtTrue(t, errors.As(dErr, &tokenErr))
+	assrtEqual(t, 4, tokenErr.Index)
+	assrtEqual(t, 8, tokenErr.Pos) // after "one two "
+	t.Log(dErr)
+}
+
+func TestTokenCodecWithLanguageCodecs(t *testing.T) {
+	// a TokenCodec, within an Interpreter of the (word-by-word) language codecs
+	english := testTokenCodec(t, "tokens", "one", "two", "three")
+	toSeq := func(v myLanguageHubDataType) (TokenSeq[int], error) { return WordSeq(" ", v...), nil }
+	fromSeq := func(v TokenSeq[int]) (myLanguageHubDataType, error) { return v.Words(), nil }
+
+	translateLang, err := New(fetchSpanishCodec(), AdaptHub(english, toSeq, fromSeq))
+	assrtNil(t, err)
+	result, tErr := translateLang.To("spanish", "tokens", "one, two; three!")
+	assrtNil(t, tErr)
+	assrtEqual(t, myLanguageContentType("uno dos tres"), result)
+	result, tErr = translateLang.To("tokens", "spanish", "tres dos")
+	assrtNil(t, tErr)
+	assrtEqual(t, myLanguageContentType("three two"), result)
+}
+
+func TestTokenizers(t *testing.T) {
+	texts := func(tokens []Token) (r []string) {
+		for _, token := range tokens {
+			r = append(r, token.Text)
+		}
+		return
+	}
+	assrtEqual(t, []string{"it's", " ", `"`, "tlhIngan", `"`, "!"}, texts(WordTokenizer(`it's "tlhIngan"!`)))
+	assrtEqual(t, []string{"'", "one", "'", " ", "’", "o’clock", "’"}, texts(WordTokenizer("'one' ’o’clock’")))
+	assrtEqual(t, []string{"wa", "'", " ", "'", "'"}, texts(WordTokenizer("wa' ''")))
+	assrtEqual(t, []string{"one,", "  ", "two"}, texts(SpaceTokenizer("one,  two")))
+	assrtEqual(t, 0, len(WordTokenizer("")))
+
+	tokens := WordTokenizer("día, dos")
+	assrtEqual(t, Token{Text: "dos", Pos: 6, Word: true}, tokens[3])
+	tokens = WordTokenizer("'día'")
+	assrtEqual(t, []Token{{Text: "'", Pos: 0}, {Text: "día", Pos: 1, Word: true}, {Text: "'", Pos: 5}}, tokens)
+}
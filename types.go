@@ -1,5 +1,7 @@
 package xl8r
 
+import "log/slog"
+
 // a function that converts the specified content for a given point
 // into hub data.
 //   - returns the hub data and a nil error, if successful
@@ -19,4 +21,19 @@ type Evaluator[P any] func(v P) (r bool)
 type Opts struct {
 	Enc map[string]any
 	Dec map[string]any
+
+	// request-scoped logger, supplied by the Interpreter (see LoggerFrom)
+	logger *slog.Logger
 }
+
+// the stage of a translation
+type Stage string
+
+const (
+	// converting origin content into hub data
+	StageEncode Stage = "encode"
+	// converting hub data into destination content
+	StageDecode Stage = "decode"
+	// checking whether content is processable by an encoder
+	StageEvaluate Stage = "evaluate"
+)
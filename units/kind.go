@@ -0,0 +1,195 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// a unit of measurement
+type Unit struct {
+	// the symbol of the unit, written by decoding (eg. "ft")
+	Symbol string
+	// other names of the unit, accepted when encoding (eg. "foot", "feet")
+	//   - names are matched case-insensitively, unless that is ambiguous (eg. "mbar" and "Mbar"),
+	//     or the name differs from a symbol by case alone (eg. "Mm" is not read as "mm")
+	Aliases []string
+	// the value of one unit, in SI base units (eg. 0.3048 for "ft")
+	Factor *big.Rat
+	// optional offset, added to values before the Factor is applied (eg. 273.15 for "°C")
+	Offset *big.Rat
+}
+
+// returns the value in SI base units, of the specified value in this unit
+func (u *Unit) toBase(v *big.Rat) (r *big.Rat) {
+	r = new(big.Rat).Set(v)
+	if u.Offset != nil {
+		r.Add(r, u.Offset)
+	}
+	r.Mul(r, u.Factor)
+	return
+}
+
+// returns the value in this unit, of the specified value in SI base units
+func (u *Unit) fromBase(v *big.Rat) (r *big.Rat) {
+	r = new(big.Rat).Quo(v, u.Factor)
+	if u.Offset != nil {
+		r.Sub(r, u.Offset)
+	}
+	return
+}
+
+// a builder of codecs, for the quantities of a single dimension
+type Kind struct {
+	// name of the codec
+	Id string
+	// the dimension of all units
+	Dim Dimension
+	// the units, the first being written by decoding (unless OptUnit is set)
+	Units []Unit
+}
+
+// builds the codec
+//   - returns an error for an invalid name, unit or duplicate unit name
+func (k *Kind) Build() (r *xl8r.Spoke[string, Quantity], e error) {
+	var errs []error
+	if len(k.Id) == 0 {
+		errs = append(errs, fmt.Errorf("empty codec name"))
+	}
+	if len(k.Units) == 0 {
+		errs = append(errs, fmt.Errorf("no units"))
+	}
+	q := &quantities{Kind: *k, exact: make(map[string]*Unit), folded: make(map[string]*Unit)}
+	q.Units = append([]Unit(nil), k.Units...)
+	ambiguous := make(map[string]bool)
+	for i := range q.Units {
+		u := &q.Units[i]
+		if len(u.Symbol) == 0 {
+			errs = append(errs, fmt.Errorf("empty unit symbol"))
+		}
+		if u.Factor == nil || u.Factor.Sign() == 0 {
+			errs = append(errs, fmt.Errorf("invalid factor of unit [ '%s' ]", u.Symbol))
+		}
+		for _, name := range append([]string{u.Symbol}, u.Aliases...) {
+			if _, dup := q.exact[name]; dup {
+				errs = append(errs, fmt.Errorf("duplicate unit name [ '%s' ]", name))
+			}
+			q.exact[name] = u
+			folded := strings.ToLower(name)
+			if other, exists := q.folded[folded]; exists && other != u {
+				ambiguous[folded] = true
+			}
+			q.folded[folded] = u
+		}
+	}
+	// symbols are case-sensitive (eg. "mm" and "Mm"), so their folded forms are only matched exactly
+	for i := range q.Units {
+		ambiguous[strings.ToLower(q.Units[i].Symbol)] = true
+	}
+	for folded := range ambiguous {
+		delete(q.folded, folded)
+	}
+	if err := errors.Join(errs...); err != nil {
+		e = fmt.Errorf("units codec [ '%s' ]: %w", k.Id, err)
+		return
+	}
+
+	r = &xl8r.Spoke[string, Quantity]{
+		Id:  k.Id,
+		Enc: q.encode,
+		Dec: q.decode,
+		Check: func(v string) (r bool) {
+			_, err := q.encode(v)
+			r = err == nil
+			return
+		},
+	}
+	return
+}
+
+type quantities struct {
+	Kind
+	// the units, by name
+	exact map[string]*Unit
+	// the units, by lower-case name
+	folded map[string]*Unit
+}
+
+// a number (eg. "12.5", "-.5" or "1e-3")
+const numberPattern = `[+-]?(?:\d+\.?\d*|\.\d+)(?:[eE][+-]?\d+)?`
+
+// a quantity (eg. "12.5 ft" or "12.5 ± 0.1 ft")
+var quantityPattern = regexp.MustCompile(`^\s*(` + numberPattern + `)\s*(?:(?:±|\+/-|\+-)\s*(` + numberPattern + `)\s*)?(\S.*?)\s*$`)
+
+// returns the unit of the specified name
+func (q *quantities) unit(name string) (r *Unit, e error) {
+	var exists bool
+	if r, exists = q.exact[name]; !exists {
+		if r, exists = q.folded[strings.ToLower(name)]; !exists {
+			e = fmt.Errorf("unknown unit of %s [ '%s' ]", q.Id, name)
+		}
+	}
+	return
+}
+
+func (q *quantities) encode(v string, _ ...xl8r.Opts) (r Quantity, e error) {
+	matches := quantityPattern.FindStringSubmatch(v)
+	if matches == nil {
+		e = fmt.Errorf("invalid quantity [ %s ]", v)
+		return
+	}
+	var u *Unit
+	if u, e = q.unit(matches[3]); e != nil {
+		return
+	}
+	value, ok := new(big.Rat).SetString(matches[1])
+	if !ok {
+		e = fmt.Errorf("invalid quantity [ %s ]", v)
+		return
+	}
+	r = Quantity{Value: u.toBase(value), Dim: q.Dim}
+	if len(matches[2]) > 0 {
+		uncertainty, ok := new(big.Rat).SetString(matches[2])
+		if !ok {
+			r, e = Quantity{}, fmt.Errorf("invalid quantity [ %s ]", v)
+			return
+		}
+		if uncertainty.Sign() < 0 {
+			r, e = Quantity{}, fmt.Errorf("negative uncertainty [ %s ]", v)
+			return
+		}
+		r.Uncertainty = uncertainty.Mul(uncertainty, new(big.Rat).Abs(u.Factor))
+	}
+	return
+}
+
+func (q *quantities) decode(v Quantity, opts0 ...xl8r.Opts) (r string, e error) {
+	var o decodeOptions
+	if o, e = readOptions(opts0); e != nil {
+		return
+	}
+	if v.Value == nil {
+		e = fmt.Errorf("nil quantity value")
+		return
+	}
+	if v.Dim != q.Dim {
+		e = &DimensionError{Codec: q.Id, Expected: q.Dim, Actual: v.Dim}
+		return
+	}
+	u := &q.Units[0]
+	if len(o.unit) > 0 {
+		if u, e = q.unit(o.unit); e != nil {
+			return
+		}
+	}
+	r = formatRat(u.fromBase(v.Value), o)
+	if v.Uncertainty != nil && v.Uncertainty.Sign() != 0 {
+		r += " ± " + formatRat(new(big.Rat).Quo(v.Uncertainty, new(big.Rat).Abs(u.Factor)), o)
+	}
+	r += " " + u.Symbol
+	return
+}
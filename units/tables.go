@@ -0,0 +1,160 @@
+package units
+
+import (
+	"math/big"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// returns the specified rational number (eg. "0.3048" or "5/9")
+func rat(s string) (r *big.Rat) {
+	var ok bool
+	if r, ok = new(big.Rat).SetString(s); !ok {
+		panic("units: invalid number " + s)
+	}
+	return
+}
+
+// returns a unit, without offset
+func unit(symbol, factor string, aliases ...string) (r Unit) {
+	r = Unit{Symbol: symbol, Aliases: aliases, Factor: rat(factor)}
+	return
+}
+
+// exact definitions, from which other units are derived
+const (
+	inch    = "0.0254"
+	pound   = "0.45359237"
+	gallon  = "0.003785411784"
+	gravity = "9.80665"
+)
+
+// returns the product of the specified rational numbers, as a string
+func product(factors ...string) (r string) {
+	x := big.NewRat(1, 1)
+	for _, f := range factors {
+		x.Mul(x, rat(f))
+	}
+	r = x.RatString()
+	return
+}
+
+// returns the quotient of the specified rational numbers, as a string
+func quotient(a, b string) (r string) {
+	r = new(big.Rat).Quo(rat(a), rat(b)).RatString()
+	return
+}
+
+// returns the codec for lengths (eg. "12.5 ft"), written in metres by default
+func Length() (r *xl8r.Spoke[string, Quantity]) {
+	r = mustBuild(&Kind{Id: "length", Dim: DimLength, Units: []Unit{
+		unit("m", "1", "metre", "metres", "meter", "meters"),
+		unit("km", "1000", "kilometre", "kilometres", "kilometer", "kilometers"),
+		unit("cm", "0.01", "centimetre", "centimetres", "centimeter", "centimeters"),
+		unit("mm", "0.001", "millimetre", "millimetres", "millimeter", "millimeters"),
+		unit("µm", "1e-6", "um", "micrometre", "micrometres", "micrometer", "micrometers"),
+		unit("nm", "1e-9", "nanometre", "nanometres", "nanometer", "nanometers"),
+		unit("in", inch, "inch", "inches", `"`),
+		unit("ft", product(inch, "12"), "foot", "feet", "'"),
+		unit("yd", product(inch, "36"), "yard", "yards"),
+		unit("mi", product(inch, "63360"), "mile", "miles"),
+		unit("nmi", "1852", "nautical mile", "nautical miles"),
+	}})
+	return
+}
+
+// returns the codec for masses (eg. "3 lb"), written in kilograms by default
+func Mass() (r *xl8r.Spoke[string, Quantity]) {
+	r = mustBuild(&Kind{Id: "mass", Dim: DimMass, Units: []Unit{
+		unit("kg", "1", "kilogram", "kilograms", "kilo", "kilos"),
+		unit("g", "0.001", "gram", "grams"),
+		unit("mg", "1e-6", "milligram", "milligrams"),
+		unit("µg", "1e-9", "ug", "microgram", "micrograms"),
+		unit("t", "1000", "tonne", "tonnes"),
+		unit("lb", pound, "lbs", "pound", "pounds"),
+		unit("oz", quotient(pound, "16"), "ounce", "ounces"),
+		unit("st", product(pound, "14"), "stone", "stones"),
+	}})
+	return
+}
+
+// returns the codec for volumes (eg. "2 gal"), written in litres by default
+//   - gallons, quarts, pints, cups and fluid ounces are those of the US
+func Volume() (r *xl8r.Spoke[string, Quantity]) {
+	r = mustBuild(&Kind{Id: "volume", Dim: DimVolume, Units: []Unit{
+		unit("L", "0.001", "l", "litre", "litres", "liter", "liters"),
+		unit("mL", "1e-6", "ml", "millilitre", "millilitres", "milliliter", "milliliters"),
+		unit("m³", "1", "m3", "cubic metre", "cubic metres", "cubic meter", "cubic meters"),
+		unit("cm³", "1e-6", "cm3", "cc"),
+		unit("gal", gallon, "gallon", "gallons"),
+		unit("qt", quotient(gallon, "4"), "quart", "quarts"),
+		unit("pt", quotient(gallon, "8"), "pint", "pints"),
+		unit("cup", quotient(gallon, "16"), "cups"),
+		unit("fl oz", quotient(gallon, "128"), "floz", "fluid ounce", "fluid ounces"),
+		unit("tbsp", quotient(gallon, "256"), "tablespoon", "tablespoons"),
+		unit("tsp", quotient(gallon, "768"), "teaspoon", "teaspoons"),
+		unit("imp gal", "0.00454609", "imperial gallon", "imperial gallons"),
+		unit("ft³", product(inch, inch, inch, "1728"), "ft3", "cubic foot", "cubic feet"),
+		unit("in³", product(inch, inch, inch), "in3", "cubic inch", "cubic inches"),
+	}})
+	return
+}
+
+// returns the codec for temperatures (eg. "-40 °F"), written in kelvins by default
+func Temperature() (r *xl8r.Spoke[string, Quantity]) {
+	celsius, fahrenheit := unit("°C", "1", "C", "degC", "celsius"), unit("°F", "5/9", "F", "degF", "fahrenheit")
+	celsius.Offset, fahrenheit.Offset = rat("273.15"), rat("459.67")
+	r = mustBuild(&Kind{Id: "temperature", Dim: DimTemperature, Units: []Unit{
+		unit("K", "1", "kelvin", "kelvins"),
+		celsius,
+		fahrenheit,
+		unit("°R", "5/9", "R", "degR", "rankine"),
+	}})
+	return
+}
+
+// returns the codec for speeds (eg. "60 mph"), written in metres per second by default
+func Speed() (r *xl8r.Spoke[string, Quantity]) {
+	r = mustBuild(&Kind{Id: "speed", Dim: DimSpeed, Units: []Unit{
+		unit("m/s", "1", "mps", "metres per second", "meters per second"),
+		unit("km/h", quotient("1000", "3600"), "kph", "kmh", "kilometres per hour", "kilometers per hour"),
+		unit("mph", quotient(product(inch, "63360"), "3600"), "mi/h", "miles per hour"),
+		unit("kn", quotient("1852", "3600"), "kt", "knot", "knots"),
+		unit("ft/s", product(inch, "12"), "fps", "feet per second"),
+	}})
+	return
+}
+
+// returns the codec for pressures (eg. "14.7 psi"), written in pascals by default
+func Pressure() (r *xl8r.Spoke[string, Quantity]) {
+	r = mustBuild(&Kind{Id: "pressure", Dim: DimPressure, Units: []Unit{
+		unit("Pa", "1", "pascal", "pascals"),
+		unit("hPa", "100", "hectopascal", "hectopascals"),
+		unit("kPa", "1000", "kilopascal", "kilopascals"),
+		unit("MPa", "1e6", "megapascal", "megapascals"),
+		unit("bar", "1e5", "bars"),
+		unit("mbar", "100", "millibar", "millibars"),
+		unit("atm", "101325", "atmosphere", "atmospheres"),
+		unit("psi", quotient(product(pound, gravity), product(inch, inch)), "lbf/in²"),
+		unit("mmHg", "133.322387415", "millimetre of mercury", "millimetres of mercury"),
+		unit("inHg", "3386.389", "inch of mercury", "inches of mercury"),
+		unit("Torr", quotient("101325", "760"), "torr"),
+	}})
+	return
+}
+
+// returns the codec for energies (eg. "250 kcal"), written in joules by default
+func Energy() (r *xl8r.Spoke[string, Quantity]) {
+	r = mustBuild(&Kind{Id: "energy", Dim: DimEnergy, Units: []Unit{
+		unit("J", "1", "joule", "joules"),
+		unit("kJ", "1000", "kilojoule", "kilojoules"),
+		unit("MJ", "1e6", "megajoule", "megajoules"),
+		unit("cal", "4.184", "calorie", "calories"),
+		unit("kcal", "4184", "kilocalorie", "kilocalories", "Cal"),
+		unit("Wh", "3600", "watt-hour", "watt-hours"),
+		unit("kWh", "3.6e6", "kilowatt-hour", "kilowatt-hours"),
+		unit("eV", "1.602176634e-19", "electronvolt", "electronvolts"),
+		unit("BTU", "1055.05585262", "Btu", "british thermal unit", "british thermal units"),
+	}})
+	return
+}
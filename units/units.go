@@ -0,0 +1,195 @@
+/*
+Package units provides codecs for physical quantities (eg. "12.5 ft" or "-40 °F"), with Quantity hub data:
+a value in SI base units, its dimension and its uncertainty.
+
+	convertUnits, err := units.New(xl8r.Config{})
+	metres, err := convertUnits.To("length", "length", "12.5 ft", xl8r.Opts{Dec: map[string]any{units.OptUnit: "m"}})	// "3.81 m"
+
+Each codec reads and writes the quantities of a single dimension, in any of its units
+(see Length, Mass, Volume, Temperature, Speed, Pressure and Energy).
+Decoding a quantity of another dimension returns a *DimensionError.
+
+Uncertainties are written after the value (eg. "12.5 ± 0.1 ft", or "12.5 +/- 0.1 ft").
+
+Decoding may be tuned with these xl8r.Opts Dec keys:
+  - OptUnit (string): the unit of the decoded quantity (by default, the first unit of the codec)
+  - OptPrecision (int): the number of decimal places (by default, as many as needed, up to 9)
+
+The codecs are also registered under the "units" domain (see xl8r.NewFromDomain).
+*/
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+// the name of the registry domain, holding the codecs of this package
+const Domain = "units"
+
+// the xl8r.Opts Dec keys, read by the decoders of this package
+const (
+	OptUnit      = "unit"
+	OptPrecision = "precision"
+)
+
+// the number of decimal places used, when no precision is specified
+// (trailing zeros are dropped)
+const defaultPrecision = 9
+
+// the exponents of the SI base quantities: length, mass, time, electric current,
+// temperature, amount of substance and luminous intensity
+type Dimension [7]int8
+
+// the symbols of the SI base units, in the order of Dimension
+var baseSymbols = [7]string{"m", "kg", "s", "A", "K", "mol", "cd"}
+
+var (
+	DimLength      = Dimension{1, 0, 0, 0, 0, 0, 0}
+	DimMass        = Dimension{0, 1, 0, 0, 0, 0, 0}
+	DimTime        = Dimension{0, 0, 1, 0, 0, 0, 0}
+	DimCurrent     = Dimension{0, 0, 0, 1, 0, 0, 0}
+	DimTemperature = Dimension{0, 0, 0, 0, 1, 0, 0}
+	DimAmount      = Dimension{0, 0, 0, 0, 0, 1, 0}
+	DimLuminosity  = Dimension{0, 0, 0, 0, 0, 0, 1}
+
+	DimVolume   = DimLength.Mul(DimLength).Mul(DimLength)
+	DimSpeed    = DimLength.Div(DimTime)
+	DimPressure = DimMass.Div(DimLength).Div(DimTime).Div(DimTime)
+	DimEnergy   = DimMass.Mul(DimSpeed).Mul(DimSpeed)
+)
+
+// returns the dimension of the product of quantities of d and o
+func (d Dimension) Mul(o Dimension) (r Dimension) {
+	for i := range d {
+		r[i] = d[i] + o[i]
+	}
+	return
+}
+
+// returns the dimension of the quotient of quantities of d and o
+func (d Dimension) Div(o Dimension) (r Dimension) {
+	for i := range d {
+		r[i] = d[i] - o[i]
+	}
+	return
+}
+
+// returns the dimension in SI base units (eg. "kg·m^-1·s^-2"), or "1" if dimensionless
+func (d Dimension) String() string {
+	var parts []string
+	for _, i := range []int{1, 0, 2, 3, 4, 5, 6} {
+		switch d[i] {
+		case 0:
+		case 1:
+			parts = append(parts, baseSymbols[i])
+		default:
+			parts = append(parts, fmt.Sprintf("%s^%d", baseSymbols[i], d[i]))
+		}
+	}
+	if len(parts) == 0 {
+		return "1"
+	}
+	return strings.Join(parts, "·")
+}
+
+// a physical quantity (ie. the hub data of this package)
+type Quantity struct {
+	// the value, in SI base units (eg. metres, kilograms or kelvins)
+	Value *big.Rat
+	// the dimension of the value
+	Dim Dimension
+	// the absolute uncertainty of the value, in SI base units (nil, if exact)
+	Uncertainty *big.Rat
+}
+
+// the error returned when a quantity of one dimension is decoded as another (eg. a length as a mass)
+type DimensionError struct {
+	// name of the codec
+	Codec string
+	// the dimension of the codec
+	Expected Dimension
+	// the dimension of the quantity
+	Actual Dimension
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("incompatible dimension [ '%s' ]: expected %v, but was %v", e.Codec, e.Expected, e.Actual)
+}
+
+// the options of a single decoding
+type decodeOptions struct {
+	unit      string
+	precision int
+	trim      bool
+}
+
+// returns the options of decoding
+func readOptions(opts0 []xl8r.Opts) (r decodeOptions, e error) {
+	r = decodeOptions{precision: defaultPrecision, trim: true}
+	if len(opts0) == 0 {
+		return
+	}
+	decoderOpts := opts0[0].Dec
+	if xValue, exists := decoderOpts[OptUnit]; exists {
+		unit, ok := xValue.(string)
+		if !ok || len(unit) == 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptUnit, xValue)
+			return
+		}
+		r.unit = unit
+	}
+	if xValue, exists := decoderOpts[OptPrecision]; exists {
+		precision, ok := xValue.(int)
+		if !ok || precision < 0 {
+			e = fmt.Errorf("invalid option [ %s ]: %v", OptPrecision, xValue)
+			return
+		}
+		r.precision, r.trim = precision, false
+	}
+	return
+}
+
+// formats the specified value, as a decimal number
+func formatRat(x *big.Rat, o decodeOptions) (r string) {
+	r = x.FloatString(o.precision)
+	if o.trim && strings.Contains(r, ".") {
+		r = strings.TrimRight(strings.TrimRight(r, "0"), ".")
+	}
+	if strings.Trim(r, "-0.") == "" {
+		// no negative zero, after rounding
+		r = strings.TrimPrefix(r, "-")
+	}
+	return
+}
+
+// builds the specified codec, and panics on error (for the codecs of this package)
+func mustBuild(k *Kind) (r *xl8r.Spoke[string, Quantity]) {
+	var err error
+	if r, err = k.Build(); err != nil {
+		panic(err)
+	}
+	return
+}
+
+// returns the codecs of this package:
+// "length", "mass", "volume", "temperature", "speed", "pressure" and "energy"
+func Codecs() (r []xl8r.Codec[string, Quantity]) {
+	r = []xl8r.Codec[string, Quantity]{Length(), Mass(), Volume(), Temperature(), Speed(), Pressure(), Energy()}
+	return
+}
+
+// creates a new Interpreter instance, with all codecs of this package
+func New(cfg xl8r.Config) (r xl8r.Interpreter[string, Quantity], e error) {
+	r, e = xl8r.NewWithConfig(cfg, Codecs()...)
+	return
+}
+
+func init() {
+	for _, c := range Codecs() {
+		xl8r.RegisterCodec(Domain, c)
+	}
+}
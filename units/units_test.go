@@ -0,0 +1,152 @@
+package units
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/eenti-utils/xl8r"
+)
+
+func TestUnits(t *testing.T) {
+	convertUnits, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := func(kv ...any) xl8r.Opts {
+		dec := make(map[string]any)
+		for i := 0; i < len(kv); i += 2 {
+			dec[kv[i].(string)] = kv[i+1]
+		}
+		return xl8r.Opts{Dec: dec}
+	}
+
+	tt := []struct {
+		to, from, text, expected string
+		opts                     xl8r.Opts
+		expectedErr              bool
+	}{
+		{to: "length", from: "length", text: "12.5 ft", opts: opts(OptUnit, "m"), expected: "3.81 m"},
+		{to: "length", from: "length", text: "12.5 ft", expected: "3.81 m"},
+		{to: "length", from: "length", text: "1 mile", opts: opts(OptUnit, "km", OptPrecision, 3), expected: "1.609 km"},
+		{to: "length", from: "length", text: "1e3 m", opts: opts(OptUnit, "km"), expected: "1 km"},
+		{to: "length", from: "length", text: "6 FEET", opts: opts(OptUnit, "yd"), expected: "2 yd"},
+		{to: "length", from: "length", text: "12.5 ± 0.1 ft", opts: opts(OptUnit, "in"), expected: "150 ± 1.2 in"},
+		{to: "length", from: "length", text: "12.5+/-0.1ft", opts: opts(OptUnit, "in"), expected: "150 ± 1.2 in"},
+		{to: "mass", from: "mass", text: "1 kg", opts: opts(OptUnit, "lb", OptPrecision, 4), expected: "2.2046 lb"},
+		{to: "mass", from: "mass", text: "16 oz", opts: opts(OptUnit, "pounds"), expected: "1 lb"},
+		{to: "volume", from: "volume", text: "1 gal", opts: opts(OptUnit, "fl oz"), expected: "128 fl oz"},
+		{to: "volume", from: "volume", text: "1 m3", expected: "1000 L"},
+		{to: "temperature", from: "temperature", text: "-40 °F", opts: opts(OptUnit, "°C"), expected: "-40 °C"},
+		{to: "temperature", from: "temperature", text: "100 celsius", opts: opts(OptUnit, "F"), expected: "212 °F"},
+		{to: "temperature", from: "temperature", text: "0 K", opts: opts(OptUnit, "°C"), expected: "-273.15 °C"},
+		{to: "temperature", from: "temperature", text: "20 ± 1 °C", opts: opts(OptUnit, "°F"), expected: "68 ± 1.8 °F"},
+		{to: "speed", from: "speed", text: "100 km/h", opts: opts(OptUnit, "mph", OptPrecision, 2), expected: "62.14 mph"},
+		{to: "speed", from: "speed", text: "1 kn", opts: opts(OptUnit, "km/h"), expected: "1.852 km/h"},
+		{to: "pressure", from: "pressure", text: "1 atm", opts: opts(OptUnit, "psi", OptPrecision, 3), expected: "14.696 psi"},
+		{to: "pressure", from: "pressure", text: "760 torr", opts: opts(OptUnit, "mbar"), expected: "1013.25 mbar"},
+		{to: "energy", from: "energy", text: "1 kWh", opts: opts(OptUnit, "MJ"), expected: "3.6 MJ"},
+		{to: "energy", from: "energy", text: "1 Cal", opts: opts(OptUnit, "cal"), expected: "1000 cal"},
+		{to: "energy", from: "energy", text: "0.00001 J", opts: opts(OptPrecision, 2), expected: "0.00 J"},
+		{to: "energy", from: "energy", text: "-0.00001 J", opts: opts(OptPrecision, 2), expected: "0.00 J"},
+		{to: "length", from: "length", text: "12.5", expectedErr: true},
+		{to: "length", from: "length", text: "ft", expectedErr: true},
+		{to: "length", from: "length", text: "12.5 kg", expectedErr: true},
+		{to: "length", from: "length", text: "12.5 ± -1 ft", expectedErr: true},
+		{to: "length", from: "length", text: "12.5 ft", opts: opts(OptUnit, "kg"), expectedErr: true},
+		{to: "length", from: "length", text: "12.5 ft", opts: opts(OptPrecision, -1), expectedErr: true},
+		{to: "energy", from: "energy", text: "1 CAL", expectedErr: true}, // ambiguous: "cal" or "Cal"
+		{to: "length", from: "length", text: "5 Mm", expectedErr: true},  // not "mm"
+		{to: "length", from: "length", text: "5 KM", expectedErr: true},
+		{to: "length", from: "length", text: "1e5000000 m", expectedErr: true},
+		{to: "length", from: "length", text: "1 ± 1e5000000 m", expectedErr: true},
+	}
+
+	for i, tx := range tt {
+		result, tErr := convertUnits.To(tx.to, tx.from, tx.text, tx.opts)
+		if result != tx.expected || (tErr != nil) != tx.expectedErr {
+			t.Errorf(`# %d: To("%s","%s","%s",%v) ==>> "%s" %v, expected "%s"`, i, tx.to, tx.from, tx.text, tx.opts.Dec, result, tErr, tx.expected)
+		}
+	}
+}
+
+func TestInvalidNumbers(t *testing.T) {
+	// numbers that match the pattern, but cannot be parsed, fail without panicking, even outside SafeMode
+	convertUnits, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range []string{"1e5000000 m", "1 ± 1e5000000 m"} {
+		if _, eErr := convertUnits.Encode("length", text); eErr == nil {
+			t.Errorf(`Encode("%s") ==>> expected an error`, text)
+		}
+		if origins := convertUnits.Origins(text); len(origins) != 0 {
+			t.Errorf(`Origins("%s") ==>> %v, expected none`, text, origins)
+		}
+	}
+}
+
+func TestIncompatibleDimensions(t *testing.T) {
+	convertUnits, err := New(xl8r.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, tErr := convertUnits.To("mass", "length", "12.5 ft")
+	var dimErr *DimensionError
+	if !errors.As(tErr, &dimErr) {
+		t.Fatalf("expected a *DimensionError, but was %v", tErr)
+	}
+	if dimErr.Codec != "mass" || dimErr.Expected != DimMass || dimErr.Actual != DimLength {
+		t.Errorf("unexpected error %+v", dimErr)
+	}
+	if expected := "incompatible dimension [ 'mass' ]: expected kg, but was m"; tErr.Error() != expected {
+		t.Errorf(`Error() ==>> "%s", expected "%s"`, tErr.Error(), expected)
+	}
+
+	if s := DimPressure.String(); s != "kg·m^-1·s^-2" {
+		t.Errorf(`DimPressure.String() ==>> "%s"`, s)
+	}
+	if s := (Dimension{}).String(); s != "1" {
+		t.Errorf(`Dimension{}.String() ==>> "%s"`, s)
+	}
+	if DimEnergy != DimPressure.Mul(DimVolume) {
+		t.Errorf("expected energy to be pressure times volume")
+	}
+}
+
+func TestKindBuild(t *testing.T) {
+	area, err := (&Kind{Id: "area", Dim: DimLength.Mul(DimLength), Units: []Unit{
+		{Symbol: "m²", Aliases: []string{"m2"}, Factor: big.NewRat(1, 1)},
+		{Symbol: "ha", Aliases: []string{"hectare", "hectares"}, Factor: big.NewRat(10000, 1)},
+		{Symbol: "acre", Aliases: []string{"acres"}, Factor: big.NewRat(40468564224, 10000000)},
+	}}).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hub, eErr := area.Encode("2.5 hectares")
+	if eErr != nil || hub.Value.Cmp(big.NewRat(25000, 1)) != 0 || hub.Uncertainty != nil {
+		t.Errorf(`Encode("2.5 hectares") ==>> %v %v`, hub, eErr)
+	}
+	if text, dErr := area.Decode(hub, xl8r.Opts{Dec: map[string]any{OptUnit: "acre", OptPrecision: 2}}); dErr != nil || text != "6.18 acre" {
+		t.Errorf(`Decode(%v) ==>> "%s" %v`, hub, text, dErr)
+	}
+	if _, dErr := area.Decode(Quantity{Dim: hub.Dim}); dErr == nil {
+		t.Errorf("expected a nil value error")
+	}
+
+	for i, k := range []*Kind{
+		{Dim: DimLength, Units: []Unit{{Symbol: "m", Factor: big.NewRat(1, 1)}}},
+		{Id: "k", Dim: DimLength},
+		{Id: "k", Dim: DimLength, Units: []Unit{{Symbol: "m"}}},
+		{Id: "k", Dim: DimLength, Units: []Unit{{Symbol: "m", Factor: big.NewRat(1, 1)}, {Symbol: "m", Factor: big.NewRat(2, 1)}}},
+	} {
+		if _, err := k.Build(); err == nil {
+			t.Errorf("# %d: expected an error", i)
+		}
+	}
+
+	names := xl8r.DomainCodecs(Domain)
+	if len(names) != len(Codecs()) {
+		t.Errorf("expected %d registered codecs, but was %d", len(Codecs()), len(names))
+	}
+}
@@ -0,0 +1,167 @@
+package xl8r
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// separates the name of a codec from its version (eg. "kana@2")
+	versionSep = "@"
+	// addresses the most recent version of a codec (eg. "kana@latest")
+	versionLatest = "latest"
+)
+
+// splits the specified codec name into its base name and version
+//   - the version is empty, if the name is unversioned
+func splitVersion(name string) (base, version string) {
+	base = name
+	if i := strings.LastIndex(name, versionSep); i >= 0 {
+		base, version = name[:i], name[i+len(versionSep):]
+	}
+	return
+}
+
+// returns bool true, if the specified codec name is unversioned,
+// or has a version made of dot-separated numbers (eg. "2" or "1.10")
+func versionIsValid(name string) (r bool) {
+	base, version := splitVersion(name)
+	if len(base) == 0 {
+		return
+	}
+	if base == name {
+		r = true
+		return
+	}
+	for _, segment := range strings.Split(version, ".") {
+		if len(segment) == 0 || strings.Trim(segment, "0123456789") != "" {
+			return
+		}
+	}
+	r = true
+	return
+}
+
+// returns an error, if the specified codec is named with an invalid version (eg. "kana@v2")
+func checkVersion[P, H any](c Codec[P, H]) (e error) {
+	if c == nil {
+		return
+	}
+	if name := c.Name(); len(name) > 0 && !versionIsValid(name) {
+		e = fmt.Errorf("invalid codec version [ '%s' ]", name)
+	}
+	return
+}
+
+// returns bool true, if the specified names differ,
+// but spell the same version of the same codec (eg. "kana@1" and "kana@1.0")
+func sameVersion(a, b string) (r bool) {
+	ba, _ := splitVersion(a)
+	bb, _ := splitVersion(b)
+	r = a != b && ba == bb && compareVersions(a, b) == 0
+	return
+}
+
+// compares the versions of two codec names
+//   - returns -1, 0 or +1
+//   - an unversioned name precedes all versioned names
+func compareVersions(a, b string) (r int) {
+	_, va := splitVersion(a)
+	_, vb := splitVersion(b)
+	switch {
+	case va == vb:
+		return
+	case len(va) == 0:
+		r = -1
+		return
+	case len(vb) == 0:
+		r = 1
+		return
+	}
+	sa, sb := strings.Split(va, "."), strings.Split(vb, ".")
+	for i := 0; i < len(sa) || i < len(sb); i++ {
+		var na, nb string
+		if i < len(sa) {
+			na = strings.TrimLeft(sa[i], "0")
+		}
+		if i < len(sb) {
+			nb = strings.TrimLeft(sb[i], "0")
+		}
+		if r = compareNumerals(na, nb); r != 0 {
+			return
+		}
+	}
+	return
+}
+
+// compares two unsigned decimal numerals, without leading zeros
+func compareNumerals(a, b string) (r int) {
+	switch {
+	case len(a) < len(b):
+		r = -1
+	case len(a) > len(b):
+		r = 1
+	case a < b:
+		r = -1
+	case a > b:
+		r = 1
+	}
+	return
+}
+
+// returns the names of all versions of the specified codec, oldest first
+//   - only names accepted by the visible function are returned (nil accepts all names)
+func (m *codecMap[P, H]) versions(name string, visible func(name string) bool) (r []string) {
+	base, _ := splitVersion(name)
+	for k := range *m {
+		if visible != nil && !visible(k) {
+			continue
+		}
+		if kb, _ := splitVersion(k); kb == base {
+			r = append(r, k)
+		}
+	}
+	sort.Slice(r, func(i, j int) bool {
+		if c := compareVersions(r[i], r[j]); c != 0 {
+			return c < 0
+		}
+		return r[i] < r[j]
+	})
+	return
+}
+
+// looks up the codec with the specified name
+//   - a registered name resolves to its own codec, so that an unversioned "name" keeps its behavior,
+//     when versions of the codec are added
+//   - "name@latest", and an unversioned "name" that is not registered, resolve to the latest version
+//   - only names accepted by the visible function are resolved (nil accepts all names)
+func (m *codecMap[P, H]) resolve(name string, visible func(name string) bool) (r Codec[P, H], b bool) {
+	if visible == nil || visible(name) {
+		if r, b = m.getIf(name); b {
+			return
+		}
+	}
+	if base, version := splitVersion(name); len(version) == 0 || version == versionLatest {
+		if versions := m.versions(base, visible); len(versions) > 0 {
+			r, b = m.getIf(versions[len(versions)-1])
+		}
+	}
+	return
+}
+
+// keeps only the latest version of each codec, among the specified names
+func latestVersions(names []string) (r []string) {
+	latest := make(map[string]string)
+	for _, name := range names {
+		base, _ := splitVersion(name)
+		prev, exists := latest[base]
+		if c := compareVersions(prev, name); !exists || c < 0 || (c == 0 && prev < name) {
+			latest[base] = name
+		}
+	}
+	for _, name := range latest {
+		r = append(r, name)
+	}
+	return
+}
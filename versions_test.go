@@ -0,0 +1,154 @@
+package xl8r
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// a codec that tags decoded content with its own name
+func testTaggingSpoke(id string, accepts ...string) *Spoke[string, string] {
+	return &Spoke[string, string]{
+		Id: id,
+		Enc: func(v string, _ ...Opts) (r string, e error) {
+			r = v
+			return
+		},
+		Dec: func(v string, _ ...Opts) (r string, e error) {
+			r = fmt.Sprintf("%s(%s)", id, v)
+			return
+		},
+		Check: func(v string) (r bool) {
+			for _, prefix := range accepts {
+				if strings.HasPrefix(v, prefix) {
+					r = true
+				}
+			}
+			return
+		},
+	}
+}
+
+func TestVersionedCodecs(t *testing.T) {
+	translate, err := New[string, string](
+		testTaggingSpoke("kana@1", "a", "b"),
+		testTaggingSpoke("kana@2", "a"),
+		testTaggingSpoke("kana@10", "a"),
+		testTaggingSpoke("romaji"),
+	)
+	assrtNil(t, err)
+
+	assrtEqual(t, []string{"kana@1", "kana@2", "kana@10"}, translate.Versions("kana"))
+	assrtEqual(t, []string{"kana@1", "kana@2", "kana@10"}, translate.Versions("kana@latest"))
+	assrtEqual(t, []string{"romaji"}, translate.Versions("romaji"))
+	assrtEqual(t, 0, len(translate.Versions("kanji")))
+
+	tt := []struct {
+		dest, expected string
+		expectedErr    error
+	}{
+		{dest: "kana@1", expected: "kana@1(x)"},
+		{dest: "kana@2", expected: "kana@2(x)"},
+		{dest: "kana@latest", expected: "kana@10(x)"},
+		{dest: "kana", expected: "kana@10(x)"},
+		{dest: "romaji", expected: "romaji(x)"},
+		{dest: "romaji@latest", expected: "romaji(x)"},
+		{dest: "kana@3", expectedErr: fmt.Errorf(`no decoder [ 'kana@3'<- ]`)},
+		{dest: "romaji@1", expectedErr: fmt.Errorf(`no decoder [ 'romaji@1'<- ]`)},
+	}
+
+	for i, tx := range tt {
+		result, tErr := translate.To(tx.dest, "romaji", "x")
+		assrtEqual(t, tx.expected, result)
+		assrtEqual(t, tx.expectedErr, tErr)
+		t.Logf(`# %d: To("%s","romaji","x") ==>> "%s" %v`, i, tx.dest, result, tErr)
+	}
+
+	assrtTrue(t, translate.Knows("kana"))
+	assrtTrue(t, translate.Knows("kana@2"))
+	assrtFalse(t, translate.Knows("kanji@v1"))
+
+	origins := translate.Origins()
+	sort.Strings(origins)
+	assrtEqual(t, []string{"kana@10", "romaji"}, origins)
+	assrtEqual(t, []string{"kana@10"}, translate.Origins("a"))
+	// the latest version that can process the content is reported
+	assrtEqual(t, []string{"kana@1"}, translate.Origins("b"))
+}
+
+func TestUnversionedCodec(t *testing.T) {
+	translate, err := New[string, string](
+		testTaggingSpoke("kana", "a"),
+		testTaggingSpoke("kana@2", "a"),
+		testTaggingSpoke("romaji"),
+	)
+	assrtNil(t, err)
+
+	assrtEqual(t, []string{"kana", "kana@2"}, translate.Versions("kana"))
+	// the registered unversioned codec keeps its behavior, after a version is added
+	result, tErr := translate.To("kana", "romaji", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "kana(x)", result)
+	// while "kana@latest" addresses the latest version, as reported by Origins
+	result, tErr = translate.To("kana@latest", "romaji", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "kana@2(x)", result)
+	assrtEqual(t, []string{"kana@2"}, translate.Origins("a"))
+}
+
+func TestEqualVersions(t *testing.T) {
+	_, err := New[string, string](testTaggingSpoke("kana@1"), testTaggingSpoke("kana@1.0"), testTaggingSpoke("romaji"))
+	assrtEqual(t, fmt.Errorf("codec version already registered [ 'kana@1.0' as 'kana@1' ]"), err)
+
+	translate, err := New[string, string](testTaggingSpoke("kana@1"), testTaggingSpoke("romaji"))
+	assrtNil(t, err)
+	for _, name := range []string{"kana@01", "kana@1.0", "kana@1.0.0"} {
+		assrtEqual(t, fmt.Errorf("codec version already registered [ '%s' as 'kana@1' ]", name),
+			translate.Register(testTaggingSpoke(name)))
+	}
+	assrtNil(t, translate.Register(testTaggingSpoke("kana@1.01"), testTaggingSpoke("kana")))
+	assrtEqual(t, []string{"kana", "kana@1", "kana@1.01"}, translate.Versions("kana"))
+}
+
+func TestInvalidVersions(t *testing.T) {
+	for _, name := range []string{"kanji@", "kanji@v1", "kanji@next", "@1"} {
+		_, err := New[string, string](testTaggingSpoke("romaji"), testTaggingSpoke("kana"), testTaggingSpoke(name))
+		assrtEqual(t, fmt.Errorf("invalid codec version [ '%s' ]", name), err)
+
+		translate, err := New[string, string](testTaggingSpoke("romaji"), testTaggingSpoke("kana"))
+		assrtNil(t, err)
+		assrtEqual(t, fmt.Errorf("invalid codec version [ '%s' ]", name), translate.Register(testTaggingSpoke(name)))
+	}
+}
+
+func TestOriginsAllVersions(t *testing.T) {
+	translate, err := NewWithConfig[string, string](Config{AllVersions: true},
+		testTaggingSpoke("kana@1", "a"),
+		testTaggingSpoke("kana@2", "a"),
+		testTaggingSpoke("romaji"),
+	)
+	assrtNil(t, err)
+
+	origins := translate.Origins("a")
+	sort.Strings(origins)
+	assrtEqual(t, []string{"kana@1", "kana@2"}, origins)
+}
+
+func TestCompareVersions(t *testing.T) {
+	tt := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "x", b: "x", expected: 0},
+		{a: "x", b: "x@1", expected: -1},
+		{a: "x@1", b: "x", expected: 1},
+		{a: "x@2", b: "x@10", expected: -1},
+		{a: "x@1.10", b: "x@1.9", expected: 1},
+		{a: "x@1.0", b: "x@1", expected: 0},
+		{a: "x@01", b: "x@1", expected: 0},
+	}
+	for _, tx := range tt {
+		assrtEqual(t, tx.expected, compareVersions(tx.a, tx.b), "compareVersions(%s, %s)", tx.a, tx.b)
+	}
+}
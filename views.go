@@ -0,0 +1,135 @@
+package xl8r
+
+import (
+	"fmt"
+	"strings"
+)
+
+// how Merge(..) handles a codec name that is known to both interpreters
+type ConflictPolicy int
+
+const (
+	// the merge fails with an error
+	ConflictError ConflictPolicy = iota
+	// the codec of the left interpreter is kept
+	PreferLeft
+	// the codec of the right interpreter is kept
+	PreferRight
+)
+
+func (x *convertr[P, H]) Subset(names ...string) (r Interpreter[P, H]) {
+	wanted := make(map[string]bool)
+	for _, name := range names {
+		wanted[x.scope+name] = true
+	}
+	r = x.Filter(func(name string) (b bool) {
+		base, _ := splitVersion(x.scope + name)
+		b = wanted[x.scope+name] || wanted[base]
+		return
+	})
+	return
+}
+
+func (x *convertr[P, H]) Filter(predicate func(name string) bool) (r Interpreter[P, H]) {
+	view := *x
+	view.allow = func(name string) (b bool) {
+		if x.allow == nil || x.allow(name) {
+			b = predicate(strings.TrimPrefix(name, x.scope))
+		}
+		return
+	}
+	r = &view
+	return
+}
+
+// combines the codecs of two interpreters, into a new Interpreter
+//   - codec names are taken as listed by each interpreter (ie. relative to any namespace view)
+//   - calls to a codec are made through the interpreter that provided it,
+//     so its Config (logging, SafeMode, Policies, ...) still applies
+//   - a codec name known to both interpreters is handled according to the ConflictPolicy
+//   - the merged Interpreter does not own the codecs: its Close() does nothing,
+//     so the interpreters a and b are still to be closed by their owner
+//   - Health() reports each codec, as reported by the interpreter that provided it
+func Merge[P, H any](a, b Interpreter[P, H], policy ConflictPolicy) (r Interpreter[P, H], e error) {
+	if a == nil || b == nil {
+		e = fmt.Errorf("cannot merge a nil Interpreter")
+		return
+	}
+	cdMap := make(codecMap[P, H])
+	for _, name := range a.List("") {
+		cdMap[name] = &routedCodec[P, H]{name: name, src: a}
+	}
+	var conflicts []string
+	for _, name := range b.List("") {
+		if _, exists := cdMap[name]; exists {
+			switch policy {
+			case PreferLeft:
+				continue
+			case PreferRight:
+			default:
+				conflicts = append(conflicts, name)
+				continue
+			}
+		}
+		cdMap[name] = &routedCodec[P, H]{name: name, src: b}
+	}
+	if len(conflicts) > 0 {
+		e = fmt.Errorf("conflicting codecs [ %s ]", strings.Join(conflicts, ", "))
+		return
+	}
+	r = &convertr[P, H]{core: &core[P, H]{
+		codecs:   cdMap,
+		limiters: make(map[string]*limiter),
+		breakers: make(map[string]*breaker),
+	}}
+	return
+}
+
+var _ Codec[int, int] = (*routedCodec[int, int])(nil) //contract
+var _ HealthChecker = (*routedCodec[int, int])(nil)   //contract
+
+// a codec, that is called through the Interpreter which provides it
+type routedCodec[P, H any] struct {
+	name string
+	src  Interpreter[P, H]
+}
+
+func (c *routedCodec[P, H]) Name() string {
+	return c.name
+}
+
+func (c *routedCodec[P, H]) Encode(v P, opts0 ...Opts) (r H, e error) {
+	r, e = c.src.Encode(c.name, v, opts0...)
+	return
+}
+
+func (c *routedCodec[P, H]) Decode(v H, opts0 ...Opts) (r P, e error) {
+	r, e = c.src.Decode(c.name, v, opts0...)
+	return
+}
+
+// reports the health of the codec, as reported by the Interpreter which provides it
+func (c *routedCodec[P, H]) Health() (e error) {
+	if x, isConvertr := c.src.(*convertr[P, H]); isConvertr {
+		e = x.codecHealth(c.name)
+		return
+	}
+	e = c.src.Health()[c.name]
+	return
+}
+
+func (c *routedCodec[P, H]) Evaluate(v P) (r bool) {
+	if x, isConvertr := c.src.(*convertr[P, H]); isConvertr {
+		if origin, exists := x.getCodecIf(c.name); exists {
+			r = x.evaluate(origin.Name(), origin, v)
+		}
+		return
+	}
+	for _, name := range c.src.Origins(v) {
+		if name == c.name {
+			r = true
+			return
+		}
+	}
+	return
+}
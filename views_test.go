@@ -0,0 +1,138 @@
+package xl8r
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSubset(t *testing.T) {
+	translateLang, err := New(definedLangTestCodecs...)
+	assrtNil(t, err)
+
+	subset := translateLang.Subset("english", "spanish", "latin")
+	assrtEqual(t, []string{"english", "spanish"}, subset.List(""))
+	assrtTrue(t, subset.Knows("english"))
+	assrtFalse(t, subset.Knows("japanese"))
+	assrtEqual(t, []string{"spanish"}, subset.Origins("dos cinco uno"))
+	assrtEqual(t, 0, len(subset.Origins("三 五 七")))
+
+	result, tErr := subset.To("english", "spanish", "dos cinco uno")
+	assrtNil(t, tErr)
+	assrtEqual(t, myLanguageContentType("two five one"), result)
+
+	_, tErr = subset.To("japanese", "spanish", "dos cinco uno")
+	assrtEqual(t, fmt.Errorf(`no decoder [ 'japanese'<- ]`), tErr)
+
+	// the original Interpreter is unaffected
+	assrtTrue(t, translateLang.Knows("japanese"))
+}
+
+func TestSubsetVersions(t *testing.T) {
+	translate, err := New[string, string](
+		testTaggingSpoke("kana@1", "a"),
+		testTaggingSpoke("kana@2", "a"),
+		testTaggingSpoke("romaji@1", "a"),
+		testTaggingSpoke("romaji@2", "a"),
+	)
+	assrtNil(t, err)
+
+	subset := translate.Subset("kana", "romaji@1")
+	assrtEqual(t, []string{"kana@1", "kana@2", "romaji@1"}, subset.List(""))
+
+	// the latest version exposed by the view is resolved
+	result, tErr := subset.To("romaji", "kana", "x")
+	assrtNil(t, tErr)
+	assrtEqual(t, "romaji@1(x)", result)
+}
+
+func TestFilter(t *testing.T) {
+	translate := testNamespacedInterpreter(t)
+
+	noKanji := translate.Filter(func(name string) bool {
+		return !strings.Contains(name, "kanji")
+	})
+	assrtEqual(t, []string{"lang/es", "lang/ja/kana", "lang/jam", "num/roman"}, noKanji.List(""))
+
+	// filters are relative to, and combine with, other views
+	ja := noKanji.Namespace("lang/ja")
+	assrtEqual(t, []string{"kana"}, ja.List(""))
+	jaKanji := translate.Namespace("lang/ja").Filter(func(name string) bool {
+		return strings.HasPrefix(name, "kanji")
+	})
+	assrtEqual(t, []string{"kanji@1", "kanji@2"}, jaKanji.List(""))
+	assrtEqual(t, []string{"kanji@1"}, jaKanji.Filter(func(name string) bool {
+		return name != "kanji@2"
+	}).List(""))
+}
+
+func TestMerge(t *testing.T) {
+	left, err := New[string, string](testTaggingSpoke("a", "x"), testTaggingSpoke("b", "x"))
+	assrtNil(t, err)
+	rightB := testTaggingSpoke("b", "y")
+	rightB.Dec = func(v string, _ ...Opts) (string, error) { return "right-b(" + v + ")", nil }
+	right, err := New[string, string](rightB, testTaggingSpoke("c", "y"))
+	assrtNil(t, err)
+
+	_, err = Merge(left, right, ConflictError)
+	assrtEqual(t, fmt.Errorf("conflicting codecs [ b ]"), err)
+
+	tt := []struct {
+		policy          ConflictPolicy
+		expectedB       string
+		expectedOrigins []string
+	}{
+		{policy: PreferLeft, expectedB: "b(z)", expectedOrigins: []string{"a", "b"}},
+		{policy: PreferRight, expectedB: "right-b(z)", expectedOrigins: []string{"a"}},
+	}
+
+	for i, tx := range tt {
+		merged, err := Merge(left, right, tx.policy)
+		assrtNil(t, err)
+		assrtEqual(t, []string{"a", "b", "c"}, merged.List(""))
+
+		result, tErr := merged.To("c", "a", "z")
+		assrtNil(t, tErr)
+		assrtEqual(t, "c(z)", result)
+		result, tErr = merged.Decode("b", "z")
+		assrtNil(t, tErr)
+		assrtEqual(t, tx.expectedB, result)
+
+		origins := merged.Origins("x")
+		sort.Strings(origins)
+		assrtEqual(t, tx.expectedOrigins, origins)
+		t.Logf("# %d: merged origins of x -- %v", i, origins)
+	}
+
+	// views may be merged, and their names are relative to the view
+	merged, err := Merge(testNamespacedInterpreter(t).Namespace("lang/ja"), left, ConflictError)
+	assrtNil(t, err)
+	assrtEqual(t, []string{"a", "b", "kana", "kanji@1", "kanji@2"}, merged.List(""))
+	result, tErr := merged.To("kanji", "a", "z")
+	assrtNil(t, tErr)
+	assrtEqual(t, "lang/ja/kanji@2(z)", result)
+
+	_, err = Merge(left, nil, PreferLeft)
+	assrtNotNil(t, err)
+}
+
+func TestMergeLifecycle(t *testing.T) {
+	var events []string
+	a := newTestLifecycleSpoke("a", &events)
+	a.healthErr = fmt.Errorf("dictionary missing")
+	left, err := New[string, string](a, testTaggingSpoke("b"))
+	assrtNil(t, err)
+	right, err := New[string, string](testTaggingSpoke("c"), testTaggingSpoke("d"))
+	assrtNil(t, err)
+
+	merged, err := Merge(left.Subset("a", "b"), right, ConflictError)
+	assrtNil(t, err)
+	assrtEqual(t, map[string]error{"a": a.healthErr, "b": nil, "c": nil, "d": nil}, merged.Health())
+
+	// the codecs are closed by their own interpreter only
+	assrtNil(t, merged.Close())
+	assrtEqual(t, []string{"init a"}, events)
+	assrtNil(t, left.Close())
+	assrtEqual(t, []string{"init a", "close a"}, events)
+}